@@ -1,14 +1,17 @@
 package main
 
 import (
+	"errors"
 	"log"
+	"strings"
 
 	"dormi-api/internal/config"
 	"dormi-api/internal/database"
-	"dormi-api/internal/dto"
 	"dormi-api/internal/handler"
+	"dormi-api/internal/mail"
 	"dormi-api/internal/middleware"
 	"dormi-api/internal/model"
+	"dormi-api/internal/password"
 	"dormi-api/internal/repository"
 	"dormi-api/internal/service"
 
@@ -30,11 +33,17 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-// @description JWT 토큰 (Bearer {token})
+// @description JWT 토큰 (Bearer {token}) 또는 API 키 (ApiKey {key})
 
 func main() {
 	cfg := config.Load()
 
+	switch cfg.StudentPurgePoints {
+	case model.PurgePointsDelete, model.PurgePointsRestrict:
+	default:
+		log.Fatalf("Unknown STUDENT_PURGE_POINTS: %s (allowed: %s, %s)", cfg.StudentPurgePoints, model.PurgePointsDelete, model.PurgePointsRestrict)
+	}
+
 	db, err := database.Connect(cfg)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
@@ -51,90 +60,253 @@ func main() {
 	dutyRepo := repository.NewDutyRepository(db)
 	dutySwapRepo := repository.NewDutySwapRequestRepository(db)
 	auditRepo := repository.NewAuditRepository(db)
+	sessionRepo := repository.NewSessionRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(db)
+	recoveryCodeRepo := repository.NewRecoveryCodeRepository(db)
+	passwordResetRepo := repository.NewPasswordResetTokenRepository(db)
+	emailChangeRepo := repository.NewEmailChangeTokenRepository(db)
+	permissionRepo := repository.NewPermissionRepository(db)
+	pointProposalRepo := repository.NewPointProposalRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	oidcAuthRequestRepo := repository.NewOIDCAuthRequestRepository(db)
+	signingKeyRepo := repository.NewSigningKeyRepository(db)
+	passwordHistoryRepo := repository.NewPasswordHistoryRepository(db)
+	guardianRepo := repository.NewGuardianRepository(db)
+	buildingRepo := repository.NewBuildingRepository(db)
+	roomRepo := repository.NewRoomRepository(db)
+	assignmentRepo := repository.NewRoomAssignmentRepository(db)
+	allocationRepo := repository.NewAllocationRepository(db)
+
+	mailer, err := mail.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to configure mail: %v", err)
+	}
+	if mailer == nil {
+		log.Printf("Warning: mail is not configured (set SMTP_HOST or MAIL_DRIVER); password reset and email change requests are disabled")
+	}
+	passwordPolicy := password.New(cfg)
+
+	permissionService := service.NewPermissionService(permissionRepo)
+	if err := permissionService.SyncDefaults(); err != nil {
+		log.Fatalf("Failed to sync permissions: %v", err)
+	}
+
+	signingKeyService := service.NewSigningKeyService(signingKeyRepo, cfg)
+	if err := signingKeyService.EnsureActiveKey(); err != nil {
+		log.Fatalf("Failed to load signing keys: %v", err)
+	}
 
-	authService := service.NewAuthService(userRepo, cfg)
+	loginThrottleService := service.NewLoginThrottleService(loginAttemptRepo, cfg)
+	mfaService := service.NewMFAService(userRepo, recoveryCodeRepo, cfg)
+	authService := service.NewAuthService(userRepo, sessionRepo, refreshTokenRepo, passwordHistoryRepo, loginThrottleService, mfaService, signingKeyService, permissionService, passwordPolicy, cfg)
 	seedAdmin(cfg, authService)
-	studentService := service.NewStudentService(studentRepo)
+	apiKeyService := service.NewAPIKeyService(userRepo, apiKeyRepo)
+	oidcService := service.NewOIDCService(userRepo, oidcAuthRequestRepo, authService, cfg)
+	passwordResetService := service.NewPasswordResetService(userRepo, passwordResetRepo, authService, loginThrottleService, mailer, cfg)
+	emailChangeService := service.NewEmailChangeService(userRepo, emailChangeRepo, passwordResetRepo, mailer, cfg)
+	roomService := service.NewRoomService(buildingRepo, roomRepo, assignmentRepo)
+	studentService := service.NewStudentService(studentRepo, assignmentRepo, roomService, cfg)
+	allocationService := service.NewAllocationService(allocationRepo, studentRepo, roomRepo)
+	rolloverService := service.NewRolloverService(studentRepo, pointRepo)
+	guardianService := service.NewGuardianService(guardianRepo, studentRepo)
 	pointReasonService := service.NewPointReasonService(pointReasonRepo)
 	pointService := service.NewPointService(pointRepo, studentRepo, pointReasonRepo)
-	dutyService := service.NewDutyService(dutyRepo)
+	pointProposalService := service.NewPointProposalService(pointProposalRepo, studentRepo, pointReasonRepo, cfg)
+	dutyService := service.NewDutyService(dutyRepo, userRepo, buildingRepo)
 	dutySwapService := service.NewDutySwapRequestService(dutySwapRepo, dutyRepo)
 	auditService := service.NewAuditService(auditRepo)
 
-	authHandler := handler.NewAuthHandler(authService, auditService)
-	studentHandler := handler.NewStudentHandler(studentService, auditService)
+	authHandler := handler.NewAuthHandler(authService, mfaService, auditService)
+	passwordResetHandler := handler.NewPasswordResetHandler(passwordResetService, auditService)
+	emailChangeHandler := handler.NewEmailChangeHandler(emailChangeService, auditService)
+	oidcHandler := handler.NewOIDCHandler(oidcService, auditService, cfg)
+	profileHandler := handler.NewProfileHandler(authService, permissionService, dutyService, dutySwapService, auditService)
+	permissionHandler := handler.NewPermissionHandler(permissionService, auditService)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService, auditService)
+	signingKeyHandler := handler.NewSigningKeyHandler(signingKeyService, auditService)
+	studentHandler := handler.NewStudentHandler(studentService, permissionService, auditService)
+	guardianHandler := handler.NewGuardianHandler(guardianService, auditService)
+	roomHandler := handler.NewRoomHandler(roomService, auditService)
+	allocationHandler := handler.NewAllocationHandler(allocationService, auditService)
+	rolloverHandler := handler.NewRolloverHandler(rolloverService, auditService)
 	pointReasonHandler := handler.NewPointReasonHandler(pointReasonService, auditService)
 	pointHandler := handler.NewPointHandler(pointService, auditService)
+	pointProposalHandler := handler.NewPointProposalHandler(pointProposalService, auditService)
 	dutyHandler := handler.NewDutyHandler(dutyService, dutySwapService, auditService)
 	auditHandler := handler.NewAuditHandler(auditService)
 
+	can := func(permissions ...model.Permission) gin.HandlerFunc {
+		return middleware.RequirePermission(permissionService, permissions...)
+	}
+
 	r := gin.Default()
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("Failed to configure trusted proxies: %v", err)
+	}
 	r.Use(cors.New(cors.Config{
-		AllowAllOrigins:  true,
+		AllowAllOrigins:  len(cfg.CORSAllowedOrigins) == 0,
+		AllowOrigins:     cfg.CORSAllowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
 		AllowCredentials: true,
 	}))
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	r.GET("/.well-known/jwks.json", signingKeyHandler.GetJWKS)
 	r.POST("/api/auth/login", authHandler.Login)
+	r.POST("/api/auth/login/mfa", authHandler.LoginMFA)
+	r.POST("/api/auth/refresh", authHandler.Refresh)
+	r.POST("/api/auth/password-reset/request", passwordResetHandler.Request)
+	r.POST("/api/auth/password-reset/confirm", passwordResetHandler.Confirm)
+	r.POST("/api/auth/email-change/confirm", emailChangeHandler.Confirm)
+	r.GET("/api/auth/oidc/providers", oidcHandler.GetProviders)
+	r.GET("/api/auth/oidc/:provider/authorize", oidcHandler.Authorize)
+	r.POST("/api/auth/oidc/:provider/callback", oidcHandler.Callback)
 
 	api := r.Group("/api")
-	api.Use(middleware.AuthMiddleware(authService))
+	api.Use(middleware.AuthMiddleware(authService, apiKeyService))
 	{
+		api.GET("/auth/me", profileHandler.GetMe)
+		api.PATCH("/auth/me", profileHandler.UpdateMe)
+		api.POST("/auth/me/email", emailChangeHandler.Request)
 		api.PATCH("/auth/password", authHandler.ChangePassword)
+		api.POST("/auth/logout", authHandler.Logout)
+		api.POST("/auth/logout-all", authHandler.LogoutAll)
+		api.GET("/auth/sessions", authHandler.GetMySessions)
+		api.DELETE("/auth/sessions/:id", authHandler.TerminateMySession)
+		api.GET("/auth/2fa", authHandler.GetMFAStatus)
+		api.POST("/auth/2fa/setup", authHandler.SetupMFA)
+		api.POST("/auth/2fa/enable", authHandler.EnableMFA)
+		api.POST("/auth/2fa/disable", authHandler.DisableMFA)
+		api.POST("/auth/2fa/recovery-codes", authHandler.RegenerateRecoveryCodes)
 
 		users := api.Group("/users")
-		users.Use(middleware.RequireAdmin())
+		users.Use(can(model.PermissionUsersManage))
 		{
 			users.GET("", authHandler.GetAllUsers)
 			users.GET("/:id", authHandler.GetUserByID)
 			users.POST("", authHandler.CreateUser)
 			users.PUT("/:id", authHandler.UpdateUser)
-			users.DELETE("/:id", authHandler.DeleteUser)
+			users.POST("/:id/deactivate", authHandler.DeactivateUser)
+			users.POST("/:id/reactivate", authHandler.ReactivateUser)
+			users.POST("/:id/impersonate", can(model.PermissionUsersImpersonate), authHandler.Impersonate)
+			users.POST("/:id/unlock", authHandler.UnlockUser)
+			users.POST("/:id/2fa/reset", authHandler.ResetUserMFA)
+			users.GET("/:id/sessions", authHandler.GetUserSessions)
+			users.DELETE("/:id/sessions/:sessionId", authHandler.TerminateUserSession)
+		}
+
+		serviceAccounts := api.Group("/service-accounts")
+		serviceAccounts.Use(can(model.PermissionAPIKeysManage))
+		{
+			serviceAccounts.GET("", apiKeyHandler.GetServiceAccounts)
+			serviceAccounts.POST("", apiKeyHandler.CreateServiceAccount)
+			serviceAccounts.GET("/:id/api-keys", apiKeyHandler.GetKeys)
+			serviceAccounts.POST("/:id/api-keys", apiKeyHandler.CreateKey)
 		}
+		api.DELETE("/api-keys/:id", can(model.PermissionAPIKeysManage), apiKeyHandler.Revoke)
 
 		students := api.Group("/students")
 		{
-			students.GET("", studentHandler.GetAll)
-			students.GET("/:id", studentHandler.GetByID)
-			students.POST("", middleware.RequireAdminOrSupervisor(), studentHandler.Create)
-			students.PUT("/:id", middleware.RequireAdminOrSupervisor(), studentHandler.Update)
-			students.DELETE("/:id", middleware.RequireAdminOrSupervisor(), studentHandler.Delete)
-			students.POST("/import", middleware.RequireAdminOrSupervisor(), studentHandler.Import)
+			students.GET("", can(model.PermissionStudentsRead), studentHandler.GetAll)
+			students.GET("/:id", can(model.PermissionStudentsRead), studentHandler.GetByID)
+			students.POST("", can(model.PermissionStudentsWrite), studentHandler.Create)
+			students.PUT("/:id", can(model.PermissionStudentsWrite), studentHandler.Update)
+			students.DELETE("/:id", can(model.PermissionStudentsWrite), studentHandler.Delete)
+			students.POST("/import", can(model.PermissionStudentsImport), studentHandler.Import)
+			students.GET("/export", can(model.PermissionStudentsExport), studentHandler.Export)
+			students.GET("/deleted", can(model.PermissionStudentsRead), studentHandler.GetDeleted)
+			students.POST("/:id/restore", can(model.PermissionStudentsWrite), studentHandler.Restore)
+			students.DELETE("/:id/purge", can(model.PermissionStudentsPurge), studentHandler.Purge)
+			students.POST("/:id/move", can(model.PermissionStudentsWrite, model.PermissionRoomsRead), studentHandler.Move)
+			students.GET("/:id/assignments", can(model.PermissionStudentsRead, model.PermissionRoomsRead), studentHandler.GetAssignments)
+			students.GET("/:id/guardians", can(model.PermissionStudentsRead, model.PermissionStudentsSensitive), guardianHandler.GetAll)
+			students.POST("/:id/guardians", can(model.PermissionStudentsWrite, model.PermissionStudentsSensitive), guardianHandler.Create)
+			students.PUT("/:id/guardians/:guardianId", can(model.PermissionStudentsWrite, model.PermissionStudentsSensitive), guardianHandler.Update)
+			students.DELETE("/:id/guardians/:guardianId", can(model.PermissionStudentsWrite, model.PermissionStudentsSensitive), guardianHandler.Delete)
+		}
+
+		buildings := api.Group("/buildings")
+		{
+			buildings.GET("", can(model.PermissionRoomsRead), roomHandler.GetBuildings)
+			buildings.POST("", can(model.PermissionRoomsManage), roomHandler.CreateBuilding)
+			buildings.PUT("/:id", can(model.PermissionRoomsManage), roomHandler.UpdateBuilding)
+			buildings.DELETE("/:id", can(model.PermissionRoomsManage), roomHandler.DeleteBuilding)
+			buildings.POST("/:id/floors", can(model.PermissionRoomsManage), roomHandler.CreateFloor)
+		}
+		api.DELETE("/floors/:id", can(model.PermissionRoomsManage), roomHandler.DeleteFloor)
+
+		rooms := api.Group("/rooms")
+		{
+			rooms.GET("", can(model.PermissionRoomsRead), roomHandler.GetRooms)
+			rooms.GET("/occupancy", can(model.PermissionRoomsRead), roomHandler.GetOccupancy)
+			rooms.GET("/residents", can(model.PermissionRoomsRead, model.PermissionStudentsRead), roomHandler.GetResidents)
+			rooms.GET("/:id", can(model.PermissionRoomsRead), roomHandler.GetRoom)
+			rooms.POST("", can(model.PermissionRoomsManage), roomHandler.CreateRoom)
+			rooms.PUT("/:id", can(model.PermissionRoomsManage), roomHandler.UpdateRoom)
+			rooms.DELETE("/:id", can(model.PermissionRoomsManage), roomHandler.DeleteRoom)
+		}
+
+		allocations := api.Group("/allocations")
+		allocations.Use(can(model.PermissionAllocationsManage))
+		{
+			allocations.GET("", allocationHandler.GetAll)
+			allocations.GET("/:id", allocationHandler.GetByID)
+			allocations.POST("", allocationHandler.Create)
+			allocations.PUT("/:id/items/:itemId", allocationHandler.UpdateItem)
+			allocations.POST("/:id/commit", allocationHandler.Commit)
+			allocations.DELETE("/:id", allocationHandler.Delete)
+		}
+
+		rollover := api.Group("/rollover")
+		rollover.Use(can(model.PermissionStudentsRollover))
+		{
+			rollover.GET("/preview", rolloverHandler.Preview)
+			rollover.POST("", rolloverHandler.Commit)
 		}
 
 		pointReasons := api.Group("/point-reasons")
 		{
-			pointReasons.GET("", pointReasonHandler.GetAll)
-			pointReasons.GET("/:id", pointReasonHandler.GetByID)
-			pointReasons.POST("", middleware.RequireAdminOrSupervisor(), pointReasonHandler.Create)
-			pointReasons.PUT("/:id", middleware.RequireAdminOrSupervisor(), pointReasonHandler.Update)
-			pointReasons.DELETE("/:id", middleware.RequireAdminOrSupervisor(), pointReasonHandler.Delete)
+			pointReasons.GET("", can(model.PermissionPointReasonsRead), pointReasonHandler.GetAll)
+			pointReasons.GET("/:id", can(model.PermissionPointReasonsRead), pointReasonHandler.GetByID)
+			pointReasons.POST("", can(model.PermissionPointReasonsWrite), pointReasonHandler.Create)
+			pointReasons.PUT("/:id", can(model.PermissionPointReasonsWrite), pointReasonHandler.Update)
+			pointReasons.DELETE("/:id", can(model.PermissionPointReasonsWrite), pointReasonHandler.Delete)
 		}
 
 		points := api.Group("/points")
 		{
-			points.GET("", pointHandler.GetAll)
-			points.GET("/student/:studentId", pointHandler.GetByStudentID)
-			points.GET("/student/:studentId/summary", pointHandler.GetSummary)
-			points.POST("", middleware.RequireAdminOrSupervisor(), pointHandler.GivePoint)
-			points.POST("/bulk", middleware.RequireAdminOrSupervisor(), pointHandler.BulkGivePoints)
-			points.PATCH("/:id/cancel", middleware.RequireAdminOrSupervisor(), pointHandler.Cancel)
-			points.DELETE("/reset", middleware.RequireAdmin(), pointHandler.Reset)
+			points.GET("", can(model.PermissionPointsRead), pointHandler.GetAll)
+			points.GET("/student/:studentId", can(model.PermissionPointsRead), pointHandler.GetByStudentID)
+			points.GET("/student/:studentId/summary", can(model.PermissionPointsRead), pointHandler.GetSummary)
+			points.POST("", can(model.PermissionPointsGive), pointHandler.GivePoint)
+			points.POST("/bulk", can(model.PermissionPointsGive), pointHandler.BulkGivePoints)
+			points.PATCH("/:id/cancel", can(model.PermissionPointsCancel), pointHandler.Cancel)
+			points.DELETE("/reset", can(model.PermissionPointsReset), pointHandler.Reset)
+		}
+
+		pointProposals := api.Group("/point-proposals")
+		{
+			pointProposals.GET("", can(model.PermissionPointsReview), pointProposalHandler.GetAll)
+			pointProposals.GET("/my", can(model.PermissionPointsPropose), pointProposalHandler.GetMine)
+			pointProposals.POST("", can(model.PermissionPointsPropose), pointProposalHandler.Create)
+			pointProposals.PATCH("/:id/approve", can(model.PermissionPointsReview), pointProposalHandler.Approve)
+			pointProposals.PATCH("/:id/reject", can(model.PermissionPointsReview), pointProposalHandler.Reject)
 		}
 
 		duties := api.Group("/duties")
 		{
-			duties.GET("", dutyHandler.GetAll)
-			duties.GET("/:id", dutyHandler.GetByID)
-			duties.POST("", middleware.RequireAdminOrSupervisor(), dutyHandler.Create)
-			duties.PUT("/:id", middleware.RequireAdminOrSupervisor(), dutyHandler.Update)
-			duties.DELETE("/:id", middleware.RequireAdminOrSupervisor(), dutyHandler.Delete)
-			duties.POST("/generate", middleware.RequireAdminOrSupervisor(), dutyHandler.Generate)
-			duties.POST("/:id/swap-requests", dutyHandler.CreateSwapRequest)
+			duties.GET("", can(model.PermissionDutiesRead), dutyHandler.GetAll)
+			duties.GET("/:id", can(model.PermissionDutiesRead), dutyHandler.GetByID)
+			duties.POST("", can(model.PermissionDutiesWrite), dutyHandler.Create)
+			duties.PUT("/:id", can(model.PermissionDutiesWrite), dutyHandler.Update)
+			duties.DELETE("/:id", can(model.PermissionDutiesWrite), dutyHandler.Delete)
+			duties.POST("/generate", can(model.PermissionDutiesWrite), dutyHandler.Generate)
+			duties.POST("/:id/swap-requests", can(model.PermissionDutiesSwap), dutyHandler.CreateSwapRequest)
 		}
 
 		dutySwapRequests := api.Group("/duty-swap-requests")
+		dutySwapRequests.Use(can(model.PermissionDutiesSwap))
 		{
 			dutySwapRequests.GET("/pending", dutyHandler.GetPendingSwapRequests)
 			dutySwapRequests.GET("/my", dutyHandler.GetMySwapRequests)
@@ -142,7 +314,22 @@ func main() {
 			dutySwapRequests.PATCH("/:id/reject", dutyHandler.RejectSwapRequest)
 		}
 
-		api.GET("/audit-logs", middleware.RequireAdmin(), auditHandler.GetAll)
+		permissions := api.Group("")
+		permissions.Use(can(model.PermissionPermissionsManage))
+		{
+			permissions.GET("/permissions", permissionHandler.GetAll)
+			permissions.GET("/roles/permissions", permissionHandler.GetRolePermissions)
+			permissions.PUT("/roles/:role/permissions", permissionHandler.UpdateRolePermissions)
+		}
+
+		signingKeys := api.Group("/signing-keys")
+		signingKeys.Use(can(model.PermissionSigningKeysManage))
+		{
+			signingKeys.GET("", signingKeyHandler.GetAll)
+			signingKeys.POST("/rotate", signingKeyHandler.Rotate)
+		}
+
+		api.GET("/audit-logs", can(model.PermissionAuditRead), auditHandler.GetAll)
 	}
 
 	log.Printf("Server starting on port %s", cfg.ServerPort)
@@ -155,13 +342,19 @@ func seedAdmin(cfg *config.Config, authService *service.AuthService) {
 	if cfg.AdminEmail == "" || cfg.AdminPassword == "" {
 		return
 	}
-	_, err := authService.CreateUser(dto.CreateUserRequest{
-		Email:    cfg.AdminEmail,
-		Password: cfg.AdminPassword,
-		Name:     "Admin",
-		Role:     string(model.RoleAdmin),
-	})
+	created, err := authService.SeedAdmin(cfg.AdminEmail, cfg.AdminPassword)
 	if err != nil {
-		log.Printf("Admin user may already exist: %v", err)
+		var policyErr *password.PolicyError
+		if errors.As(err, &policyErr) {
+			messages := make([]string, len(policyErr.Violations))
+			for i, violation := range policyErr.Violations {
+				messages[i] = violation.Message
+			}
+			log.Fatalf("ADMIN_PASSWORD does not meet the password policy: %s", strings.Join(messages, "; "))
+		}
+		log.Fatalf("Failed to seed admin user: %v", err)
+	}
+	if !created {
+		log.Printf("Admin user %s already exists, skipping seed", cfg.AdminEmail)
 	}
 }
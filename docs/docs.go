@@ -15,64 +15,47 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/audit-logs": {
+        "/allocations": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "감사 로그 목록 조회 (관리자 전용)",
+                "description": "배정안 목록과 요약 조회",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "감사로그"
+                    "호실배정"
                 ],
-                "summary": "감사 로그 조회",
+                "summary": "호실 배정안 목록",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "사용자 ID",
-                        "name": "userId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "작업 유형",
-                        "name": "action",
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "엔티티 유형",
-                        "name": "entityType",
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "시작일 (RFC3339)",
-                        "name": "startDate",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "종료일 (RFC3339)",
-                        "name": "endDate",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "페이지",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "페이지당 개수",
-                        "name": "limit",
+                        "default": "-createdAt",
+                        "description": "정렬 (createdAt, name, 내림차순은 - 접두사)",
+                        "name": "sort",
                         "in": "query"
                     }
                 ],
@@ -90,7 +73,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.AuditLogResponse"
+                                                "$ref": "#/definitions/dto.AllocationPlanResponse"
                                             }
                                         }
                                     }
@@ -103,19 +86,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
                     }
                 }
-            }
-        },
-        "/auth/login": {
+            },
             "post": {
-                "description": "이메일과 비밀번호로 로그인하여 JWT 토큰 발급",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "호실 현황과 조건(학년, 성별, 룸메이트 희망, 기존 호실 유지, 고정 배정)으로 배정안 미리보기 생성",
                 "consumes": [
                     "application/json"
                 ],
@@ -123,23 +103,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "인증"
+                    "호실배정"
                 ],
-                "summary": "로그인",
+                "summary": "호실 배정안 생성",
                 "parameters": [
                     {
-                        "description": "로그인 정보",
+                        "description": "배정 조건",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
+                            "$ref": "#/definitions/dto.CreateAllocationPlanRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -149,7 +129,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.LoginResponse"
+                                            "$ref": "#/definitions/dto.AllocationPlanResponse"
                                         }
                                     }
                                 }
@@ -161,122 +141,89 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
                     }
                 }
             }
         },
-        "/auth/password": {
-            "patch": {
+        "/allocations/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "본인 계정 비밀번호 변경",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "학생별 배정 결과, 미배정 사유, 지켜지지 않은 룸메이트 희망 조회",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "인증"
+                    "호실배정"
                 ],
-                "summary": "비밀번호 변경",
+                "summary": "호실 배정안 상세",
                 "parameters": [
                     {
-                        "description": "비밀번호 변경 정보",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ChangePasswordRequest"
-                        }
+                        "type": "string",
+                        "description": "배정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.AllocationPlanResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
                     }
                 }
-            }
-        },
-        "/duties": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "당직 목록 조회 (필터링 지원)",
+                "description": "확정되지 않은 배정안 삭제",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직"
+                    "호실배정"
                 ],
-                "summary": "당직 목록 조회",
+                "summary": "호실 배정안 삭제",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "유형 (DORM, NIGHT_STUDY)",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "담당자 ID",
-                        "name": "assigneeId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "시작일 (YYYY-MM-DD)",
-                        "name": "startDate",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "종료일 (YYYY-MM-DD)",
-                        "name": "endDate",
-                        "in": "query"
+                        "description": "배정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.DutyResponse"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/dto.Response"
                         }
                     },
                     "400": {
@@ -286,38 +233,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/allocations/{id}/commit": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "새로운 당직 일정 생성",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "배정안을 한 번에 적용 (하나라도 실패하면 전체 취소, 미배정 학생은 기존 침대 유지, 확정 시점에 성별과 침대 점유를 다시 확인)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직"
+                    "호실배정"
                 ],
-                "summary": "당직 생성",
+                "summary": "호실 배정안 확정",
                 "parameters": [
                     {
-                        "description": "당직 정보",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateDutyRequest"
-                        }
+                        "type": "string",
+                        "description": "배정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -327,7 +271,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.DutyResponse"
+                                            "$ref": "#/definitions/dto.AllocationPlanResponse"
                                         }
                                     }
                                 }
@@ -343,14 +287,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/duties/generate": {
-            "post": {
+        "/allocations/{id}/items/{itemId}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "기간과 담당자 목록으로 당직 자동 생성",
+                "description": "배정안에서 학생의 침대를 직접 지정하거나 배정 해제 (bedId를 비우면 해제)",
                 "consumes": [
                     "application/json"
                 ],
@@ -358,23 +302,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "당직"
+                    "호실배정"
                 ],
-                "summary": "당직 자동 생성",
+                "summary": "호실 배정안 수정",
                 "parameters": [
                     {
-                        "description": "자동 생성 정보",
+                        "type": "string",
+                        "description": "배정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "배정 항목 ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "침대 정보",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.GenerateDutyRequest"
+                            "$ref": "#/definitions/dto.UpdateAllocationItemRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -384,10 +342,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.DutyResponse"
-                                            }
+                                            "$ref": "#/definitions/dto.AllocationPlanResponse"
                                         }
                                     }
                                 }
@@ -403,25 +358,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/duties/{id}": {
-            "get": {
+        "/api-keys/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "ID로 당직 정보 조회",
+                "description": "API 키 폐기 (즉시 사용 불가)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직"
+                    "서비스 계정"
                 ],
-                "summary": "당직 상세 조회",
+                "summary": "API 키 폐기",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "당직 ID",
+                        "description": "API 키 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -439,7 +394,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.DutyResponse"
+                                            "$ref": "#/definitions/dto.APIKeyResponse"
                                         }
                                     }
                                 }
@@ -451,53 +406,145 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/audit-logs": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "당직 정보 수정",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "감사 로그 목록 조회 (관리자 전용)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직"
+                    "감사로그"
                 ],
-                "summary": "당직 수정",
+                "summary": "감사 로그 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "당직 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "사용자 ID",
+                        "name": "userId",
+                        "in": "query"
                     },
                     {
-                        "description": "수정할 정보",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateDutyRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "type": "string",
+                        "description": "대리 접속한 관리자 ID",
+                        "name": "impersonatorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "작업 유형",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "엔티티 유형",
+                        "name": "entityType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "시작일 (RFC3339)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "종료일 (RFC3339)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-createdAt",
+                        "description": "정렬 (createdAt, action, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.AuditLogResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/2fa": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "본인 계정의 2단계 인증 활성화 여부와 남은 복구 코드 수 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "2단계 인증 상태 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -507,7 +554,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.DutyResponse"
+                                            "$ref": "#/definitions/dto.MFAStatusResponse"
                                         }
                                     }
                                 }
@@ -521,28 +568,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/auth/2fa/disable": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "당직 정보 삭제",
+                "description": "OTP 코드를 확인하여 2단계 인증 해제 (필수 역할은 해제 불가)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직"
+                    "인증"
                 ],
-                "summary": "당직 삭제",
+                "summary": "2단계 인증 해제",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "당직 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "OTP 코드",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MFACodeRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -561,14 +615,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/duties/{id}/swap-requests": {
+        "/auth/2fa/enable": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "다른 사람의 당직과 교대 신청",
+                "description": "인증 앱의 OTP 코드를 확인하여 2단계 인증을 활성화하고 복구 코드 발급",
                 "consumes": [
                     "application/json"
                 ],
@@ -576,30 +630,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "당직 교대"
+                    "인증"
                 ],
-                "summary": "당직 교대 신청",
+                "summary": "2단계 인증 활성화",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "내 당직 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "교대 대상 당직 ID",
+                        "description": "OTP 코드",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateDutySwapRequest"
+                            "$ref": "#/definitions/dto.MFACodeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -609,7 +656,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.DutySwapRequestResponse"
+                                            "$ref": "#/definitions/dto.MFARecoveryCodesResponse"
                                         }
                                     }
                                 }
@@ -625,21 +672,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/duty-swap-requests/my": {
-            "get": {
+        "/auth/2fa/recovery-codes": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "내가 신청한 교대 목록 조회",
+                "description": "OTP 코드를 확인하여 복구 코드 재발급 (기존 복구 코드는 폐기됨)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직 교대"
+                    "인증"
+                ],
+                "summary": "복구 코드 재발급",
+                "parameters": [
+                    {
+                        "description": "OTP 코드",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MFACodeRequest"
+                        }
+                    }
                 ],
-                "summary": "내가 신청한 교대 목록",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -652,34 +713,37 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.DutySwapRequestResponse"
-                                            }
+                                            "$ref": "#/definitions/dto.MFARecoveryCodesResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
             }
         },
-        "/duty-swap-requests/pending": {
-            "get": {
+        "/auth/2fa/setup": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "나에게 온 대기 중인 교대 신청 목록 조회",
+                "description": "TOTP 비밀키와 인증 앱 등록용 otpauth URI 발급",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직 교대"
+                    "인증"
                 ],
-                "summary": "받은 교대 신청 목록",
+                "summary": "2단계 인증 등록 시작",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -692,48 +756,63 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.DutySwapRequestResponse"
-                                            }
+                                            "$ref": "#/definitions/dto.MFASetupResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
             }
         },
-        "/duty-swap-requests/{id}/approve": {
-            "patch": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/auth/email-change/confirm": {
+            "post": {
+                "description": "새 이메일로 받은 토큰으로 이메일 변경 완료 (토큰은 1회용, 대기 중인 비밀번호 재설정 링크는 무효화됨)",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "받은 교대 신청 승인",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직 교대"
+                    "인증"
                 ],
-                "summary": "교대 신청 승인",
+                "summary": "이메일 변경 확인",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "교대 신청 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "확인 토큰",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmailChangeConfirmRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -745,67 +824,104 @@ const docTemplate = `{
                 }
             }
         },
-        "/duty-swap-requests/{id}/reject": {
-            "patch": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/auth/login": {
+            "post": {
+                "description": "이메일과 비밀번호로 로그인하여 액세스 토큰과 리프레시 토큰 발급 (2단계 인증 사용 시 mfaToken 발급)",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "받은 교대 신청 거절",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "당직 교대"
+                    "인증"
                 ],
-                "summary": "교대 신청 거절",
+                "summary": "로그인",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "교대 신청 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "로그인 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.LoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
             }
         },
-        "/point-reasons": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/auth/login/mfa": {
+            "post": {
+                "description": "로그인 시 발급된 mfaToken과 OTP 코드 또는 복구 코드로 로그인 완료",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "모든 상벌점 사유 조회",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점사유"
+                    "인증"
                 ],
-                "summary": "상벌점 사유 목록",
+                "summary": "2단계 인증 로그인",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "유형 (REWARD, PENALTY)",
-                        "name": "type",
-                        "in": "query"
+                        "description": "2단계 인증 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MFALoginRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -820,67 +936,89 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.PointReasonResponse"
-                                            }
+                                            "$ref": "#/definitions/dto.LoginResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
-            },
+            }
+        },
+        "/auth/logout": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "새로운 상벌점 사유 등록",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "현재 세션을 종료하고 발급된 토큰 폐기",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점사유"
+                    "인증"
                 ],
-                "summary": "상벌점 사유 생성",
-                "parameters": [
-                    {
-                        "description": "사유 정보",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                "summary": "로그아웃",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.CreatePointReasonRequest"
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
                         }
                     }
+                }
+            }
+        },
+        "/auth/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "본인 계정의 모든 세션을 종료하고 발급된 토큰 폐기",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
                 ],
+                "summary": "모든 기기에서 로그아웃",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.PointReasonResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/dto.Response"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
@@ -888,30 +1026,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/point-reasons/{id}": {
+        "/auth/me": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "ID로 상벌점 사유 조회",
+                "description": "로그인한 사용자 정보와 권한, 다가오는 당직, 대기 중인 교대 신청 조회",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점사유"
-                ],
-                "summary": "상벌점 사유 상세",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "사유 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "인증"
                 ],
+                "summary": "내 정보 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -924,7 +1053,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.PointReasonResponse"
+                                            "$ref": "#/definitions/dto.MeResponse"
                                         }
                                     }
                                 }
@@ -936,16 +1065,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "상벌점 사유 수정",
+                "description": "로그인한 사용자의 이름 수정 (이메일은 POST /auth/me/email로 확인 절차를 거쳐 변경)",
                 "consumes": [
                     "application/json"
                 ],
@@ -953,24 +1088,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점사유"
+                    "인증"
                 ],
-                "summary": "상벌점 사유 수정",
+                "summary": "내 정보 수정",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "사유 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "description": "수정할 정보",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdatePointReasonRequest"
+                            "$ref": "#/definitions/dto.UpdateProfileRequest"
                         }
                     }
                 ],
@@ -986,7 +1114,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.PointReasonResponse"
+                                            "$ref": "#/definitions/dto.UserResponse"
                                         }
                                     }
                                 }
@@ -1000,28 +1128,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/auth/me/email": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "상벌점 사유 삭제",
+                "description": "현재 비밀번호 확인 후 새 이메일로 확인 링크 발송 (확인 전까지 이메일은 바뀌지 않음, 이메일은 소문자로 저장됨)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점사유"
+                    "인증"
                 ],
-                "summary": "상벌점 사유 삭제",
+                "summary": "이메일 변경 요청",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "사유 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "새 이메일과 현재 비밀번호",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmailChangeRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1036,51 +1171,26 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
             }
         },
-        "/points": {
+        "/auth/oidc/providers": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "상벌점 목록 조회 (필터링 지원)",
+                "description": "사용 가능한 OpenID Connect 로그인 제공자 목록 조회",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점"
-                ],
-                "summary": "상벌점 목록 조회",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "학생 ID",
-                        "name": "studentId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "유형 (REWARD, PENALTY)",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "시작일 (YYYY-MM-DD)",
-                        "name": "startDate",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "종료일 (YYYY-MM-DD)",
-                        "name": "endDate",
-                        "in": "query"
-                    }
+                    "인증"
                 ],
+                "summary": "SSO 제공자 목록",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1095,29 +1205,67 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.PointResponse"
+                                                "type": "string"
                                             }
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "학생에게 상벌점 부여",
+            }
+        },
+        "/auth/oidc/{provider}/authorize": {
+            "get": {
+                "description": "OpenID Connect 인가 URL 발급 (authorization code + PKCE, 반환된 URL로 이동 후 콜백에서 code와 state 전달, 요청한 브라우저에 HttpOnly 쿠키를 설정하며 콜백도 같은 브라우저에서 쿠키와 함께 호출해야 함)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "SSO 로그인 시작",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "제공자 이름",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.OIDCAuthorizeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/{provider}/callback": {
+            "post": {
+                "description": "제공자에서 받은 code와 state로 로그인하여 토큰 발급 (인가 URL을 발급받은 브라우저의 쿠키 필요, 검증된 이메일과 일치하는 기존 사용자만 로그인 가능, 2단계 인증 사용 시 mfaToken 발급)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1125,23 +1273,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점"
+                    "인증"
                 ],
-                "summary": "상벌점 부여",
+                "summary": "SSO 로그인 완료",
                 "parameters": [
                     {
-                        "description": "상벌점 정보",
+                        "type": "string",
+                        "description": "제공자 이름",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "인가 코드와 state",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.GivePointRequest"
+                            "$ref": "#/definitions/dto.OIDCCallbackRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -1151,7 +1306,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.PointResponse"
+                                            "$ref": "#/definitions/dto.LoginResponse"
                                         }
                                     }
                                 }
@@ -1163,18 +1318,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
             }
         },
-        "/points/bulk": {
-            "post": {
+        "/auth/password": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "여러 학생에게 동시에 상벌점 부여",
+                "description": "본인 계정 비밀번호 변경 (다른 세션은 모두 종료되고 현재 세션에는 새 토큰 발급)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1182,23 +1349,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점"
+                    "인증"
                 ],
-                "summary": "상벌점 다건 부여",
+                "summary": "비밀번호 변경",
                 "parameters": [
                     {
-                        "description": "다건 상벌점 정보",
+                        "description": "비밀번호 변경 정보",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.BulkGivePointRequest"
+                            "$ref": "#/definitions/dto.ChangePasswordRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -1208,10 +1375,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.PointResponse"
-                                            }
+                                            "$ref": "#/definitions/dto.LoginResponse"
                                         }
                                     }
                                 }
@@ -1227,21 +1391,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/points/reset": {
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/auth/password-reset/confirm": {
+            "post": {
+                "description": "메일로 받은 토큰으로 새 비밀번호 설정 (토큰은 1회용, 기존 세션은 모두 종료됨)",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "모든 상벌점 삭제 (관리자 전용)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점"
+                    "인증"
+                ],
+                "summary": "비밀번호 재설정",
+                "parameters": [
+                    {
+                        "description": "재설정 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PasswordResetConfirmRequest"
+                        }
+                    }
                 ],
-                "summary": "상벌점 전체 초기화",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1249,8 +1422,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.Response"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
@@ -1258,28 +1431,74 @@ const docTemplate = `{
                 }
             }
         },
-        "/points/student/{studentId}": {
-            "get": {
-                "security": [
+        "/auth/password-reset/request": {
+            "post": {
+                "description": "등록된 이메일로 비밀번호 재설정 링크 발송 (계정 존재 여부와 관계없이 동일하게 응답)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "비밀번호 재설정 요청",
+                "parameters": [
                     {
-                        "BearerAuth": []
+                        "description": "이메일",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PasswordResetRequest"
+                        }
                     }
                 ],
-                "description": "특정 학생의 상벌점 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "리프레시 토큰으로 새 액세스 토큰과 리프레시 토큰 발급 (사용한 리프레시 토큰은 폐기됨)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점"
+                    "인증"
                 ],
-                "summary": "학생별 상벌점 조회",
+                "summary": "토큰 갱신",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "학생 ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
+                        "description": "리프레시 토큰",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshTokenRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1294,10 +1513,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.PointResponse"
-                                            }
+                                            "$ref": "#/definitions/dto.LoginResponse"
                                         }
                                     }
                                 }
@@ -1309,32 +1525,58 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
                     }
                 }
             }
         },
-        "/points/student/{studentId}/summary": {
+        "/auth/sessions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "특정 학생의 상벌점 요약 정보 조회",
+                "description": "본인 계정의 활성 세션 목록 조회 (기기, IP, 마지막 사용 시각)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점"
+                    "인증"
                 ],
-                "summary": "학생별 상벌점 요약",
+                "summary": "내 로그인 세션 목록",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "학생 ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-lastSeenAt",
+                        "description": "정렬 (lastSeenAt, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1343,13 +1585,16 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/dto.Response"
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.PointSummary"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.SessionResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -1365,25 +1610,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/points/{id}/cancel": {
-            "patch": {
+        "/auth/sessions/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "상벌점 취소 (soft delete)",
+                "description": "본인 계정의 특정 세션을 원격으로 로그아웃",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "상벌점"
+                    "인증"
                 ],
-                "summary": "상벌점 취소",
+                "summary": "내 세션 종료",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "상벌점 ID",
+                        "description": "세션 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1405,38 +1650,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/students": {
+        "/buildings": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "학생 목록 조회 (검색, 필터링 지원)",
+                "description": "건물과 층 목록 조회",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "학생"
+                    "호실"
                 ],
-                "summary": "학생 목록 조회",
+                "summary": "건물 목록",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "검색어 (이름, 학번)",
-                        "name": "search",
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "학년",
-                        "name": "grade",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "방 번호",
-                        "name": "room",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "정렬 (name, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
                         "in": "query"
                     }
                 ],
@@ -1446,7 +1700,7 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/dto.Response"
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
                                 },
                                 {
                                     "type": "object",
@@ -1454,7 +1708,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.StudentResponse"
+                                                "$ref": "#/definitions/dto.BuildingResponse"
                                             }
                                         }
                                     }
@@ -1476,7 +1730,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "새로운 학생 등록",
+                "description": "새로운 건물 등록",
                 "consumes": [
                     "application/json"
                 ],
@@ -1484,17 +1738,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "학생"
+                    "호실"
                 ],
-                "summary": "학생 생성",
+                "summary": "건물 등록",
                 "parameters": [
                     {
-                        "description": "학생 정보",
+                        "description": "건물 정보",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateStudentRequest"
+                            "$ref": "#/definitions/dto.BuildingRequest"
                         }
                     }
                 ],
@@ -1510,7 +1764,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.StudentResponse"
+                                            "$ref": "#/definitions/dto.BuildingResponse"
                                         }
                                     }
                                 }
@@ -1522,48 +1776,63 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
                     }
                 }
             }
         },
-        "/students/import": {
-            "post": {
+        "/buildings/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "CSV 파일로 학생 일괄 등록",
+                "description": "건물 이름 수정",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "학생"
+                    "호실"
                 ],
-                "summary": "CSV 일괄 등록",
+                "summary": "건물 수정",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV 파일",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "건물 ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "건물 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BuildingRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.BuildingResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1573,27 +1842,25 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/students/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "ID로 학생 정보 조회",
+                "description": "층이 없는 건물 삭제",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "학생"
+                    "호실"
                 ],
-                "summary": "학생 상세 조회",
+                "summary": "건물 삭제",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "학생 ID",
+                        "description": "건물 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1603,19 +1870,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/dto.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.StudentResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/dto.Response"
                         }
                     },
                     "400": {
@@ -1623,22 +1878,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/buildings/{id}/floors": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "학생 정보 수정",
+                "description": "건물에 층 등록",
                 "consumes": [
                     "application/json"
                 ],
@@ -1646,30 +1897,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "학생"
+                    "호실"
                 ],
-                "summary": "학생 수정",
+                "summary": "층 등록",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "학생 ID",
+                        "description": "건물 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "수정할 정보",
+                        "description": "층 정보",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateStudentRequest"
+                            "$ref": "#/definitions/dto.CreateFloorRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -1679,7 +1930,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.StudentResponse"
+                                            "$ref": "#/definitions/dto.FloorResponse"
                                         }
                                     }
                                 }
@@ -1693,35 +1944,96 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/duties": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "학생 정보 삭제 (soft delete)",
+                "description": "당직 목록 조회 (필터링 지원)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "학생"
+                    "당직"
                 ],
-                "summary": "학생 삭제",
+                "summary": "당직 목록 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "학생 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "유형 (DORM, NIGHT_STUDY)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "담당자 ID",
+                        "name": "assigneeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "시작일 (YYYY-MM-DD)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "종료일 (YYYY-MM-DD)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "date",
+                        "description": "정렬 (date, type, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.DutyResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1731,26 +2043,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "모든 사용자 목록 조회 (관리자 전용)",
+                "description": "새로운 당직 일정 생성",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "사용자"
+                    "당직"
+                ],
+                "summary": "당직 생성",
+                "parameters": [
+                    {
+                        "description": "당직 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateDutyRequest"
+                        }
+                    }
                 ],
-                "summary": "사용자 목록",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "allOf": [
                                 {
@@ -1760,37 +2084,30 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.UserResponse"
-                                            }
+                                            "$ref": "#/definitions/dto.DutyResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/duties/generate": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "새로운 사용자 계정 생성 (관리자 전용)",
+                "description": "기간과 담당자 목록으로 당직 자동 생성 (비활성화된 담당자는 제외)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1798,17 +2115,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "사용자"
+                    "당직"
                 ],
-                "summary": "사용자 생성",
+                "summary": "당직 자동 생성",
                 "parameters": [
                     {
-                        "description": "사용자 정보",
+                        "description": "자동 생성 정보",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateUserRequest"
+                            "$ref": "#/definitions/dto.GenerateDutyRequest"
                         }
                     }
                 ],
@@ -1824,7 +2141,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.UserResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.DutyResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -1836,41 +2156,29 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.Response"
                         }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
                     }
                 }
             }
         },
-        "/users/{id}": {
+        "/duties/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "ID로 사용자 정보 조회 (관리자 전용)",
+                "description": "ID로 당직 정보 조회",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "사용자"
+                    "당직"
                 ],
-                "summary": "사용자 상세",
+                "summary": "당직 상세 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "사용자 ID",
+                        "description": "당직 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1888,7 +2196,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.UserResponse"
+                                            "$ref": "#/definitions/dto.DutyResponse"
                                         }
                                     }
                                 }
@@ -1915,7 +2223,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "사용자 정보 수정 (관리자 전용)",
+                "description": "당직 정보 수정",
                 "consumes": [
                     "application/json"
                 ],
@@ -1923,13 +2231,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "사용자"
+                    "당직"
                 ],
-                "summary": "사용자 수정",
+                "summary": "당직 수정",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "사용자 ID",
+                        "description": "당직 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1940,7 +2248,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                            "$ref": "#/definitions/dto.UpdateDutyRequest"
                         }
                     }
                 ],
@@ -1956,7 +2264,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.UserResponse"
+                                            "$ref": "#/definitions/dto.DutyResponse"
                                         }
                                     }
                                 }
@@ -1977,18 +2285,18 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "사용자 삭제 (관리자 전용)",
+                "description": "당직 정보 삭제",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "사용자"
+                    "당직"
                 ],
-                "summary": "사용자 삭제",
+                "summary": "당직 삭제",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "사용자 ID",
+                        "description": "당직 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2009,274 +2317,5503 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.AuditLogResponse": {
+        },
+        "/duties/{id}/swap-requests": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "다른 사람의 당직과 교대 신청",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "당직 교대"
+                ],
+                "summary": "당직 교대 신청",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "내 당직 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "교대 대상 당직 ID",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateDutySwapRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DutySwapRequestResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/duty-swap-requests/my": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "내가 신청한 교대 목록 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "당직 교대"
+                ],
+                "summary": "내가 신청한 교대 목록",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-createdAt",
+                        "description": "정렬 (createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.DutySwapRequestResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/duty-swap-requests/pending": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "나에게 온 대기 중인 교대 신청 목록 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "당직 교대"
+                ],
+                "summary": "받은 교대 신청 목록",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-createdAt",
+                        "description": "정렬 (createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.DutySwapRequestResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/duty-swap-requests/{id}/approve": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "받은 교대 신청 승인",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "당직 교대"
+                ],
+                "summary": "교대 신청 승인",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "교대 신청 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/duty-swap-requests/{id}/reject": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "받은 교대 신청 거절",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "당직 교대"
+                ],
+                "summary": "교대 신청 거절",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "교대 신청 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/floors/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "호실이 없는 층 삭제",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "층 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "층 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "시스템에서 사용하는 모든 권한 목록 조회 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "권한"
+                ],
+                "summary": "권한 목록",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/point-proposals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "상벌점 제안 목록 조회 (필터링 지원)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점 제안"
+                ],
+                "summary": "상벌점 제안 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "상태 (PENDING, APPROVED, REJECTED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "제안자 ID",
+                        "name": "proposedBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-createdAt",
+                        "description": "정렬 (createdAt, status, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.PointProposalResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생회가 상벌점 부여를 제안 (학생회 허용 사유만, 일일 제한 있음)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점 제안"
+                ],
+                "summary": "상벌점 제안",
+                "parameters": [
+                    {
+                        "description": "제안 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePointProposalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointProposalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/point-proposals/my": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "내가 제안한 상벌점 목록 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점 제안"
+                ],
+                "summary": "내 상벌점 제안 목록",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-createdAt",
+                        "description": "정렬 (createdAt, status, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.PointProposalResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/point-proposals/{id}/approve": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "대기 중인 제안을 승인하고 상벌점 부여. 승인 시점에 사유의 학생회 제안 허용 여부와 제안자의 일일 한도를 다시 확인하며, 부여된 상벌점에는 제안자와 제안 ID가 함께 기록됨",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점 제안"
+                ],
+                "summary": "상벌점 제안 승인",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "제안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointProposalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/point-proposals/{id}/reject": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "대기 중인 제안 거절",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점 제안"
+                ],
+                "summary": "상벌점 제안 거절",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "제안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "거절 사유",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RejectPointProposalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointProposalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/point-reasons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "모든 상벌점 사유 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점사유"
+                ],
+                "summary": "상벌점 사유 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "유형 (REWARD, PENALTY)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "정렬 (name, type, score, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.PointReasonResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "새로운 상벌점 사유 등록",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점사유"
+                ],
+                "summary": "상벌점 사유 생성",
+                "parameters": [
+                    {
+                        "description": "사유 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePointReasonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointReasonResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/point-reasons/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "ID로 상벌점 사유 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점사유"
+                ],
+                "summary": "상벌점 사유 상세",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사유 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointReasonResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "상벌점 사유 수정",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점사유"
+                ],
+                "summary": "상벌점 사유 수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사유 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdatePointReasonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointReasonResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "상벌점 사유 삭제",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점사유"
+                ],
+                "summary": "상벌점 사유 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사유 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/points": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "상벌점 목록 조회 (필터링 지원)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점"
+                ],
+                "summary": "상벌점 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "유형 (REWARD, PENALTY)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "시작일 (YYYY-MM-DD)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "종료일 (YYYY-MM-DD)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "졸업생의 보관된 상벌점 포함",
+                        "name": "includeArchived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-givenAt",
+                        "description": "정렬 (givenAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.PointResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생에게 상벌점 부여",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점"
+                ],
+                "summary": "상벌점 부여",
+                "parameters": [
+                    {
+                        "description": "상벌점 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GivePointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/points/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "여러 학생에게 동시에 상벌점 부여",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점"
+                ],
+                "summary": "상벌점 다건 부여",
+                "parameters": [
+                    {
+                        "description": "다건 상벌점 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkGivePointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.PointResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/points/reset": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "보관되지 않은 모든 상벌점 삭제 (졸업생의 보관된 상벌점은 유지, 관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점"
+                ],
+                "summary": "상벌점 전체 초기화",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/points/student/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "특정 학생의 상벌점 목록 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점"
+                ],
+                "summary": "학생별 상벌점 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-givenAt",
+                        "description": "정렬 (givenAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.PointResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/points/student/{studentId}/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "특정 학생의 상벌점 요약 정보 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점"
+                ],
+                "summary": "학생별 상벌점 요약",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.PointSummary"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/points/{id}/cancel": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "상벌점 취소 (soft delete)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "상벌점"
+                ],
+                "summary": "상벌점 취소",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "상벌점 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "역할별로 부여된 권한 목록 조회 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "권한"
+                ],
+                "summary": "역할별 권한 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RolePermissionsResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{role}/permissions": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "역할에 부여된 권한 목록을 교체 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "권한"
+                ],
+                "summary": "역할 권한 수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "역할 (ADMIN, SUPERVISOR, COUNCIL)",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "권한 목록",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRolePermissionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RolePermissionsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rollover": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "미리보기 내용대로 진급과 졸업을 한 번에 처리. 졸업생은 졸업생(ALUMNI) 상태로 삭제 처리되고 침대 배정이 해제되며 상벌점은 보관됨. 미리보기 이후 학생 정보가 바뀌었으면 실패",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학년도"
+                ],
+                "summary": "학년도 전환",
+                "parameters": [
+                    {
+                        "description": "미리보기 checksum",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RolloverRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RolloverResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rollover/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "진급(1→2, 2→3), 졸업(3학년) 대상 학생과 보관될 상벌점 수 조회. 전환 시 응답의 checksum이 필요",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학년도"
+                ],
+                "summary": "학년도 전환 미리보기",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RolloverResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "호실 목록과 침대별 배정 현황 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "호실 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "건물 ID",
+                        "name": "buildingId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "층",
+                        "name": "floor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "성별 (MALE, FEMALE)",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "빈 자리가 있는 호실만",
+                        "name": "vacantOnly",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "number",
+                        "description": "정렬 (number, capacity, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RoomResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "층에 호실 등록 (정원만큼 침대 생성)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "호실 등록",
+                "parameters": [
+                    {
+                        "description": "호실 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RoomResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/occupancy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "건물, 층별 정원, 배정 인원, 빈 자리 수 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "층별 수용 현황",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.FloorOccupancyResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/residents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "특정 날짜에 호실에 배정되어 있던 학생 조회 (삭제된 학생 포함)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "날짜별 호실 거주자",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "건물 ID (같은 호실 번호가 여러 건물에 있을 때)",
+                        "name": "buildingId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "호실 번호",
+                        "name": "room",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "날짜 (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RoomAssignmentResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "호실과 침대별 배정 현황 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "호실 상세",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "호실 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RoomResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "호실 번호, 정원, 성별 지정 수정 (정원을 줄이면 빈 침대부터 제거)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "호실 수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "호실 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RoomResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "배정된 학생이 없는 호실 삭제",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "호실"
+                ],
+                "summary": "호실 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "호실 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-accounts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "모든 서비스 계정 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "서비스 계정"
+                ],
+                "summary": "서비스 계정 목록",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "정렬 (name, email, role, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.UserResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "키오스크, 연동 스크립트 등에서 사용할 서비스 계정 생성 (비밀번호 로그인 불가)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "서비스 계정"
+                ],
+                "summary": "서비스 계정 생성",
+                "parameters": [
+                    {
+                        "description": "서비스 계정 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateServiceAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-accounts/{id}/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "서비스 계정의 API 키 목록 조회",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "서비스 계정"
+                ],
+                "summary": "API 키 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "서비스 계정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-createdAt",
+                        "description": "정렬 (createdAt, name, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.APIKeyResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "서비스 계정의 API 키 발급 (키 원문은 발급 시에만 반환, 요청 시 Authorization: ApiKey {key} 헤더 사용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "서비스 계정"
+                ],
+                "summary": "API 키 발급",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "서비스 계정 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "API 키 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.APIKeyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/signing-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "토큰 서명 및 검증에 사용 중인 키 목록 조회 (비밀 키는 포함하지 않음)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "서명 키"
+                ],
+                "summary": "서명 키 목록",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.SigningKeyResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/signing-keys/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "새 서명 키를 생성하여 사용 (기존 키는 발급된 토큰이 만료될 때까지 검증용으로 JWKS에 유지)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "서명 키"
+                ],
+                "summary": "서명 키 교체",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.SigningKeyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생 목록 조회 (검색, 필터링 지원)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "학생 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "검색어 (이름, 학번)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "학년",
+                        "name": "grade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "건물 ID",
+                        "name": "buildingId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "방 번호",
+                        "name": "room",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "studentNumber",
+                        "description": "정렬 (studentNumber, name, grade, roomNumber, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.StudentResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "새로운 학생 등록 (생년월일, 연락처, 건강 메모, 비상 연락처 입력은 민감 정보 권한 필요)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "학생 생성",
+                "parameters": [
+                    {
+                        "description": "학생 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.StudentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/deleted": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "삭제된 학생 목록 조회 (졸업생 제외, 최근 삭제 순)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "삭제된 학생 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "이름 또는 학번 검색",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "학년",
+                        "name": "grade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-deletedAt",
+                        "description": "정렬 (deletedAt, studentNumber, name, grade, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.StudentResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "검색 조건에 맞는 학생 목록을 XLSX 또는 CSV(UTF-8) 파일로 내보내기. 내보낸 파일은 그대로 일괄 등록에 사용할 수 있음",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "학생 목록 내보내기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "xlsx",
+                        "description": "파일 형식 (xlsx, csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이름 또는 학번 검색",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "학년",
+                        "name": "grade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "건물 ID",
+                        "name": "buildingId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "호실",
+                        "name": "room",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "CSV(UTF-8, EUC-KR) 또는 XLSX 파일로 학생 일괄 등록/수정 (학번 기준, 헤더로 열 구분). 오류가 있는 행이 하나라도 있으면 아무것도 반영하지 않음",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "CSV/XLSX 일괄 등록",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV 또는 XLSX 파일",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "XLSX 시트 이름 (기본: 첫 번째 시트)",
+                        "name": "sheet",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "검증만 하고 반영하지 않음",
+                        "name": "dryRun",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.StudentImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "details": {
+                                            "$ref": "#/definitions/dto.StudentImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/students/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "ID로 학생 정보 조회 (민감 정보 권한이 있으면 profile 포함)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "학생 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.StudentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생 정보 수정 (생년월일, 연락처, 건강 메모, 비상 연락처 수정은 민감 정보 권한 필요. 생년월일, 연락처, 건강 메모는 빈 값을 보내면 삭제)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "학생 수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.StudentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생 정보 삭제 (soft delete)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "학생 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/{id}/assignments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생의 호실 배정 이력 조회 (최근 순)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "호실 배정 이력",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-startedAt",
+                        "description": "정렬 (startedAt, endedAt, 내림차순은 - 접두사, 진행 중인 배정의 endedAt은 가장 늦은 값으로 취급)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RoomAssignmentResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/{id}/guardians": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생의 보호자 목록 조회 (민감 정보 권한 필요)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "보호자"
+                ],
+                "summary": "보호자 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.GuardianResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생의 보호자 등록 (학생당 최대 5명, 주 보호자는 한 명)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "보호자"
+                ],
+                "summary": "보호자 등록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "보호자 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GuardianRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.GuardianResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/{id}/guardians/{guardianId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생의 보호자 정보 수정",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "보호자"
+                ],
+                "summary": "보호자 수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "보호자 ID",
+                        "name": "guardianId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "보호자 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GuardianRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.GuardianResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생의 보호자 삭제",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "보호자"
+                ],
+                "summary": "보호자 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "보호자 ID",
+                        "name": "guardianId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "학생을 다른 호실/침대로 이동 (이전 배정은 종료되고 이력으로 남음)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "호실 이동",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "이동 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MoveStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.StudentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/{id}/purge": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "삭제된 학생을 영구 삭제 (졸업생 제외, 보호자 포함). 호실 배정 이력은 학생 연결만 끊고 학번과 이름을 남긴 채 보존. 상벌점은 STUDENT_PURGE_POINTS 설정에 따라 함께 삭제하거나 영구 삭제를 거부",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "학생 영구 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/students/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "삭제된 학생을 복구 (졸업생은 복구 불가). 같은 학번의 재학생이 있으면 실패하며, 이전 침대가 비어 있지 않으면 침대 배정 없이 복구",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "학생"
+                ],
+                "summary": "삭제된 학생 복구",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "학생 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.StudentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "모든 사용자 목록 조회 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "상태 (ACTIVE, INACTIVE)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "역할 (ADMIN, SUPERVISOR, COUNCIL)",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "정렬 (name, email, role, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.UserResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "새로운 사용자 계정 생성 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 생성",
+                "parameters": [
+                    {
+                        "description": "사용자 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "ID로 사용자 정보 조회 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 상세",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "사용자 정보 수정 (관리자 전용, 역할이나 비밀번호 변경 시 해당 사용자의 토큰 폐기, 마지막 활성 관리자의 역할은 변경 불가)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정할 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/2fa/reset": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "인증 기기를 분실한 사용자의 2단계 인증 초기화 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 2단계 인증 초기화",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "사용자 계정 비활성화 (관리자 전용, 로그인 차단 및 모든 세션 폐기, 기록은 유지)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 비활성화",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/impersonate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "대상 사용자로 접속하는 임시 토큰 발급 (users:impersonate 권한 필요, 갱신 불가, 모든 작업은 접속한 사용자 기록과 함께 감사 로그에 남음)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 대리 접속",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.LoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/reactivate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "비활성화된 사용자 계정 재활성화 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 재활성화",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "특정 사용자의 활성 세션 목록 조회 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 세션 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "페이지",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "페이지당 개수 (최대 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-lastSeenAt",
+                        "description": "정렬 (lastSeenAt, createdAt, 내림차순은 - 접두사)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.SessionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/sessions/{sessionId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "특정 사용자의 세션을 원격으로 로그아웃 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "사용자 세션 종료",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "세션 ID",
+                        "name": "sessionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "로그인 실패로 잠긴 계정의 잠금 해제 (관리자 전용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "계정 잠금 해제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "lastUsedAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.AllocationItemResponse": {
+            "type": "object",
+            "properties": {
+                "bedId": {
+                    "type": "string"
+                },
+                "bedLabel": {
+                    "type": "string"
+                },
+                "currentRoomNumber": {
+                    "type": "string"
+                },
+                "fixed": {
+                    "type": "boolean"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "grade": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "roomNumber": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "studentNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AllocationPlanResponse": {
+            "type": "object",
+            "properties": {
+                "committedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "groupByGrade": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AllocationItemResponse"
+                    }
+                },
+                "keepCurrentRoom": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "summary": {
+                    "$ref": "#/definitions/dto.AllocationSummaryResponse"
+                },
+                "unmetRoommateRequests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RoommateRequestResponse"
+                    }
+                }
+            }
+        },
+        "dto.AllocationSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "assigned": {
+                    "type": "integer"
+                },
+                "moved": {
+                    "type": "integer"
+                },
+                "students": {
+                    "type": "integer"
+                },
+                "unassigned": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "apiKeyId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "details": {},
+                "entityId": {
+                    "type": "string"
+                },
+                "entityType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "impersonator": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "ipAddress": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                }
+            }
+        },
+        "dto.BedResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "occupant": {
+                    "$ref": "#/definitions/dto.StudentResponse"
+                }
+            }
+        },
+        "dto.BuildingRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.BuildingResponse": {
+            "type": "object",
+            "properties": {
+                "floors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FloorResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BulkGivePointRequest": {
+            "type": "object",
+            "required": [
+                "reasonId",
+                "studentIds"
+            ],
+            "properties": {
+                "reasonId": {
+                    "type": "string"
+                },
+                "studentIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "currentPassword",
+                "newPassword"
+            ],
+            "properties": {
+                "currentPassword": {
+                    "type": "string"
+                },
+                "newPassword": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateAllocationPlanRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "fixedAssignments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FixedAssignmentRequest"
+                    }
+                },
+                "groupByGrade": {
+                    "type": "boolean"
+                },
+                "keepCurrentRoom": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "roommateRequests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RoommateRequest"
+                    }
+                },
+                "studentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateDutyRequest": {
+            "type": "object",
+            "required": [
+                "assigneeId",
+                "date",
+                "type"
+            ],
+            "properties": {
+                "assigneeId": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "floor": {
+                    "type": "integer"
+                },
+                "floorId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "DORM",
+                        "NIGHT_STUDY"
+                    ]
+                }
+            }
+        },
+        "dto.CreateDutySwapRequest": {
+            "type": "object",
+            "required": [
+                "targetDutyId"
+            ],
+            "properties": {
+                "targetDutyId": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateFloorRequest": {
+            "type": "object",
+            "required": [
+                "number"
+            ],
+            "properties": {
+                "number": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.CreatePointProposalRequest": {
+            "type": "object",
+            "required": [
+                "reasonId",
+                "studentId"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "reasonId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreatePointReasonRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "score",
+                "type"
+            ],
+            "properties": {
+                "councilAllowed": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "REWARD",
+                        "PENALTY"
+                    ]
+                }
+            }
+        },
+        "dto.CreateRoomRequest": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "floorId",
+                "number"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "floorId": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "MALE",
+                        "FEMALE"
+                    ]
+                },
+                "number": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.CreateServiceAccountRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "role"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "ADMIN",
+                        "SUPERVISOR",
+                        "COUNCIL"
+                    ]
+                }
+            }
+        },
+        "dto.CreateStudentRequest": {
+            "type": "object",
+            "required": [
+                "grade",
+                "name",
+                "studentNumber"
+            ],
+            "properties": {
+                "bedId": {
+                    "type": "string"
+                },
+                "birthDate": {
+                    "type": "string"
+                },
+                "buildingId": {
+                    "type": "string"
+                },
+                "emergencyContacts": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "$ref": "#/definitions/dto.EmergencyContactRequest"
+                    }
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "MALE",
+                        "FEMALE"
+                    ]
+                },
+                "grade": {
+                    "type": "integer",
+                    "maximum": 3,
+                    "minimum": 1
+                },
+                "homeroomClass": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "medicalNotes": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "roomNumber": {
+                    "type": "string"
+                },
+                "studentNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "ADMIN",
+                        "SUPERVISOR",
+                        "COUNCIL"
+                    ]
+                }
+            }
+        },
+        "dto.DutyResponse": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "floor": {
+                    "type": "integer"
+                },
+                "floorId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DutySwapRequestResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "requester": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "sourceDuty": {
+                    "$ref": "#/definitions/dto.DutyResponse"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "targetDuty": {
+                    "$ref": "#/definitions/dto.DutyResponse"
+                }
+            }
+        },
+        "dto.EmailChangeConfirmRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EmailChangeRequest": {
+            "type": "object",
+            "required": [
+                "currentPassword",
+                "newEmail"
+            ],
+            "properties": {
+                "currentPassword": {
+                    "type": "string"
+                },
+                "newEmail": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EmergencyContactRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "phone"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "relationship": {
+                    "type": "string",
+                    "maxLength": 30
+                }
+            }
+        },
+        "dto.EmergencyContactResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "relationship": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FixedAssignmentRequest": {
+            "type": "object",
+            "required": [
+                "bedId",
+                "studentId"
+            ],
+            "properties": {
+                "bedId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FloorOccupancyResponse": {
+            "type": "object",
+            "properties": {
+                "buildingId": {
+                    "type": "string"
+                },
+                "buildingName": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "floor": {
+                    "type": "integer"
+                },
+                "floorId": {
+                    "type": "string"
+                },
+                "occupied": {
+                    "type": "integer"
+                },
+                "rooms": {
+                    "type": "integer"
+                },
+                "vacancies": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.FloorResponse": {
+            "type": "object",
+            "properties": {
+                "buildingId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.GenerateDutyRequest": {
+            "type": "object",
+            "required": [
+                "assigneeIds",
+                "endDate",
+                "startDate",
+                "type"
+            ],
+            "properties": {
+                "assigneeIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "floor": {
+                    "type": "integer"
+                },
+                "floorId": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "DORM",
+                        "NIGHT_STUDY"
+                    ]
+                }
+            }
+        },
+        "dto.GivePointRequest": {
+            "type": "object",
+            "required": [
+                "reasonId",
+                "studentId"
+            ],
+            "properties": {
+                "reasonId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GuardianRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "phone",
+                "relationship"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "emailConsent": {
+                    "type": "boolean"
+                },
+                "isPrimary": {
+                    "type": "boolean"
+                },
+                "medicalConsent": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "relationship": {
+                    "type": "string",
+                    "maxLength": 30
+                },
+                "smsConsent": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.GuardianResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "emailConsent": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isPrimary": {
+                    "type": "boolean"
+                },
+                "medicalConsent": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "relationship": {
+                    "type": "string"
+                },
+                "smsConsent": {
+                    "type": "boolean"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "impersonator": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "mfaEnrollmentRequired": {
+                    "type": "boolean"
+                },
+                "mfaRequired": {
+                    "type": "boolean"
+                },
+                "mfaToken": {
+                    "type": "string"
+                },
+                "passwordChangeRequired": {
+                    "type": "boolean"
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                }
+            }
+        },
+        "dto.MFACodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MFALoginRequest": {
+            "type": "object",
+            "required": [
+                "mfaToken"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "mfaToken": {
+                    "type": "string"
+                },
+                "recoveryCode": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MFARecoveryCodesResponse": {
+            "type": "object",
+            "properties": {
+                "recoveryCodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.MFASetupResponse": {
+            "type": "object",
+            "properties": {
+                "otpauthUrl": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MFAStatusResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "remainingRecoveryCodes": {
+                    "type": "integer"
+                },
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.MeResponse": {
+            "type": "object",
+            "properties": {
+                "impersonator": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "myPendingSwapRequests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DutySwapRequestResponse"
+                    }
+                },
+                "pendingSwapRequests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DutySwapRequestResponse"
+                    }
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "upcomingDuties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DutyResponse"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                }
+            }
+        },
+        "dto.MoveStudentRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "bedId": {
+                    "type": "string"
+                },
+                "buildingId": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "roomNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OIDCAuthorizeResponse": {
+            "type": "object",
+            "properties": {
+                "authorizationUrl": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OIDCCallbackRequest": {
             "type": "object",
+            "required": [
+                "code",
+                "state"
+            ],
             "properties": {
-                "action": {
+                "code": {
                     "type": "string"
                 },
-                "createdAt": {
+                "state": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.PaginatedResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "meta": {
+                    "$ref": "#/definitions/dto.Pagination"
                 },
-                "details": {},
-                "entityId": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.Pagination": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
                 },
-                "entityType": {
+                "nextCursor": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "ipAddress": {
+                "sort": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/dto.UserResponse"
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.BulkGivePointRequest": {
+        "dto.PasswordResetConfirmRequest": {
             "type": "object",
             "required": [
-                "reasonId",
-                "studentIds"
+                "newPassword",
+                "token"
             ],
             "properties": {
-                "reasonId": {
+                "newPassword": {
                     "type": "string"
                 },
-                "studentIds": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "type": "string"
-                    }
+                "token": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ChangePasswordRequest": {
+        "dto.PasswordResetRequest": {
             "type": "object",
             "required": [
-                "currentPassword",
-                "newPassword"
+                "email"
             ],
             "properties": {
-                "currentPassword": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PointProposalResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "newPassword": {
-                    "type": "string",
-                    "minLength": 6
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "pointId": {
+                    "type": "string"
+                },
+                "proposedBy": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "reason": {
+                    "$ref": "#/definitions/dto.PointReasonResponse"
+                },
+                "reviewComment": {
+                    "type": "string"
+                },
+                "reviewedAt": {
+                    "type": "string"
+                },
+                "reviewedBy": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "student": {
+                    "$ref": "#/definitions/dto.StudentResponse"
                 }
             }
         },
-        "dto.CreateDutyRequest": {
+        "dto.PointReasonResponse": {
             "type": "object",
-            "required": [
-                "assigneeId",
-                "date",
-                "type"
-            ],
             "properties": {
-                "assigneeId": {
+                "councilAllowed": {
+                    "type": "boolean"
+                },
+                "id": {
                     "type": "string"
                 },
-                "date": {
+                "name": {
                     "type": "string"
                 },
-                "floor": {
+                "score": {
                     "type": "integer"
                 },
                 "type": {
-                    "type": "string",
-                    "enum": [
-                        "DORM",
-                        "NIGHT_STUDY"
-                    ]
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateDutySwapRequest": {
+        "dto.PointResponse": {
             "type": "object",
-            "required": [
-                "targetDutyId"
-            ],
             "properties": {
-                "targetDutyId": {
+                "archivedAt": {
+                    "type": "string"
+                },
+                "cancelled": {
+                    "type": "boolean"
+                },
+                "cancelledAt": {
+                    "type": "string"
+                },
+                "givenAt": {
                     "type": "string"
+                },
+                "givenBy": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "proposalId": {
+                    "type": "string"
+                },
+                "proposedBy": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "reason": {
+                    "$ref": "#/definitions/dto.PointReasonResponse"
+                },
+                "student": {
+                    "$ref": "#/definitions/dto.StudentResponse"
                 }
             }
         },
-        "dto.CreatePointReasonRequest": {
+        "dto.PointSummary": {
             "type": "object",
-            "required": [
-                "name",
-                "score",
-                "type"
-            ],
             "properties": {
-                "name": {
+                "netScore": {
+                    "type": "integer"
+                },
+                "studentId": {
                     "type": "string"
                 },
-                "score": {
-                    "type": "integer",
-                    "minimum": 1
+                "totalPenalty": {
+                    "type": "integer"
                 },
-                "type": {
-                    "type": "string",
-                    "enum": [
-                        "REWARD",
-                        "PENALTY"
-                    ]
+                "totalReward": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.CreateStudentRequest": {
+        "dto.RefreshTokenRequest": {
             "type": "object",
             "required": [
-                "grade",
-                "name",
-                "roomNumber",
-                "studentNumber"
+                "refreshToken"
             ],
             "properties": {
-                "grade": {
-                    "type": "integer",
-                    "maximum": 3,
-                    "minimum": 1
-                },
-                "name": {
+                "refreshToken": {
                     "type": "string"
-                },
-                "roomNumber": {
+                }
+            }
+        },
+        "dto.RejectPointProposalRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "dto.Response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "details": {},
+                "error": {
                     "type": "string"
                 },
-                "studentNumber": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.RolePermissionsResponse": {
+            "type": "object",
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role": {
                     "type": "string"
                 }
             }
         },
-        "dto.CreateUserRequest": {
+        "dto.RolloverRequest": {
             "type": "object",
             "required": [
-                "email",
-                "name",
-                "password",
-                "role"
+                "checksum"
             ],
             "properties": {
-                "email": {
+                "checksum": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RolloverResponse": {
+            "type": "object",
+            "properties": {
+                "archivedPoints": {
+                    "type": "integer"
+                },
+                "checksum": {
                     "type": "string"
                 },
+                "graduated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RolloverStudentResponse"
+                    }
+                },
+                "promoted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RolloverStudentResponse"
+                    }
+                }
+            }
+        },
+        "dto.RolloverStudentResponse": {
+            "type": "object",
+            "properties": {
+                "fromGrade": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "roomNumber": {
+                    "type": "string"
                 },
-                "role": {
-                    "type": "string",
-                    "enum": [
-                        "ADMIN",
-                        "SUPERVISOR",
-                        "COUNCIL"
-                    ]
+                "studentId": {
+                    "type": "string"
+                },
+                "studentNumber": {
+                    "type": "string"
+                },
+                "toGrade": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.DutyResponse": {
+        "dto.RoomAssignmentResponse": {
             "type": "object",
             "properties": {
-                "assignee": {
-                    "$ref": "#/definitions/dto.UserResponse"
-                },
-                "createdAt": {
+                "bedId": {
                     "type": "string"
                 },
-                "date": {
+                "bedLabel": {
                     "type": "string"
                 },
-                "floor": {
-                    "type": "integer"
+                "endedAt": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "type": {
+                "reason": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.DutySwapRequestResponse": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
+                },
+                "roomId": {
                     "type": "string"
                 },
-                "id": {
+                "roomNumber": {
                     "type": "string"
                 },
-                "requester": {
-                    "$ref": "#/definitions/dto.UserResponse"
+                "startedAt": {
+                    "type": "string"
                 },
-                "sourceDuty": {
-                    "$ref": "#/definitions/dto.DutyResponse"
+                "student": {
+                    "$ref": "#/definitions/dto.StudentResponse"
                 },
-                "status": {
+                "studentId": {
                     "type": "string"
                 },
-                "targetDuty": {
-                    "$ref": "#/definitions/dto.DutyResponse"
+                "studentName": {
+                    "type": "string"
+                },
+                "studentNumber": {
+                    "type": "string"
                 }
             }
         },
-        "dto.GenerateDutyRequest": {
+        "dto.RoomResponse": {
             "type": "object",
-            "required": [
-                "assigneeIds",
-                "endDate",
-                "startDate",
-                "type"
-            ],
             "properties": {
-                "assigneeIds": {
+                "beds": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.BedResponse"
                     }
                 },
-                "endDate": {
+                "buildingId": {
+                    "type": "string"
+                },
+                "buildingName": {
                     "type": "string"
                 },
+                "capacity": {
+                    "type": "integer"
+                },
                 "floor": {
                     "type": "integer"
                 },
-                "startDate": {
+                "floorId": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string",
-                    "enum": [
-                        "DORM",
-                        "NIGHT_STUDY"
-                    ]
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "occupied": {
+                    "type": "integer"
+                },
+                "vacancies": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.GivePointRequest": {
+        "dto.RoommateRequest": {
             "type": "object",
             "required": [
-                "reasonId",
+                "roommateId",
                 "studentId"
             ],
             "properties": {
-                "reasonId": {
+                "roommateId": {
                     "type": "string"
                 },
                 "studentId": {
@@ -2284,156 +7821,178 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.RoommateRequestResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "roommateId": {
                     "type": "string"
                 },
-                "password": {
+                "studentId": {
                     "type": "string"
                 }
             }
         },
-        "dto.LoginResponse": {
+        "dto.SessionResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "authMethod": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/dto.UserResponse"
-                }
-            }
-        },
-        "dto.PaginatedResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "meta": {
-                    "$ref": "#/definitions/dto.Pagination"
+                "createdAt": {
+                    "type": "string"
                 },
-                "success": {
+                "current": {
                     "type": "boolean"
-                }
-            }
-        },
-        "dto.Pagination": {
-            "type": "object",
-            "properties": {
-                "limit": {
-                    "type": "integer"
-                },
-                "page": {
-                    "type": "integer"
                 },
-                "total": {
-                    "type": "integer"
+                "expiresAt": {
+                    "type": "string"
                 },
-                "totalPages": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.PointReasonResponse": {
-            "type": "object",
-            "properties": {
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "impersonatorId": {
                     "type": "string"
                 },
-                "score": {
-                    "type": "integer"
+                "ipAddress": {
+                    "type": "string"
                 },
-                "type": {
+                "lastSeenAt": {
+                    "type": "string"
+                },
+                "userAgent": {
                     "type": "string"
                 }
             }
         },
-        "dto.PointResponse": {
+        "dto.SigningKeyResponse": {
             "type": "object",
             "properties": {
-                "cancelled": {
+                "active": {
                     "type": "boolean"
                 },
-                "cancelledAt": {
+                "algorithm": {
                     "type": "string"
                 },
-                "givenAt": {
+                "createdAt": {
                     "type": "string"
                 },
-                "givenBy": {
-                    "$ref": "#/definitions/dto.UserResponse"
-                },
                 "id": {
                     "type": "string"
                 },
-                "reason": {
-                    "$ref": "#/definitions/dto.PointReasonResponse"
+                "retiresAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StudentImportError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
                 },
-                "student": {
-                    "$ref": "#/definitions/dto.StudentResponse"
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.PointSummary": {
+        "dto.StudentImportResponse": {
             "type": "object",
             "properties": {
-                "netScore": {
+                "created": {
                     "type": "integer"
                 },
-                "studentId": {
-                    "type": "string"
+                "dryRun": {
+                    "type": "boolean"
                 },
-                "totalPenalty": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StudentImportError"
+                    }
+                },
+                "skipped": {
                     "type": "integer"
                 },
-                "totalReward": {
+                "updated": {
                     "type": "integer"
                 }
             }
         },
-        "dto.Response": {
+        "dto.StudentProfileResponse": {
             "type": "object",
             "properties": {
-                "data": {},
-                "error": {
+                "birthDate": {
                     "type": "string"
                 },
-                "success": {
-                    "type": "boolean"
+                "emergencyContacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EmergencyContactResponse"
+                    }
+                },
+                "medicalNotes": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
                 }
             }
         },
         "dto.StudentResponse": {
             "type": "object",
             "properties": {
+                "bedId": {
+                    "type": "string"
+                },
                 "createdAt": {
                     "type": "string"
                 },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
                 "grade": {
                     "type": "integer"
                 },
+                "graduatedAt": {
+                    "type": "string"
+                },
+                "homeroomClass": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "profile": {
+                    "$ref": "#/definitions/dto.StudentProfileResponse"
+                },
                 "roomNumber": {
                     "type": "string"
                 },
+                "status": {
+                    "type": "string"
+                },
                 "studentNumber": {
                     "type": "string"
                 }
             }
         },
+        "dto.UpdateAllocationItemRequest": {
+            "type": "object",
+            "properties": {
+                "bedId": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.UpdateDutyRequest": {
             "type": "object",
             "properties": {
@@ -2446,6 +8005,9 @@ const docTemplate = `{
                 "floor": {
                     "type": "integer"
                 },
+                "floorId": {
+                    "type": "string"
+                },
                 "type": {
                     "type": "string",
                     "enum": [
@@ -2458,6 +8020,9 @@ const docTemplate = `{
         "dto.UpdatePointReasonRequest": {
             "type": "object",
             "properties": {
+                "councilAllowed": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2474,17 +8039,94 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateRolePermissionsRequest": {
+            "type": "object",
+            "required": [
+                "permissions"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.UpdateRoomRequest": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "MALE",
+                        "FEMALE"
+                    ]
+                },
+                "number": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
         "dto.UpdateStudentRequest": {
             "type": "object",
             "properties": {
+                "bedId": {
+                    "type": "string"
+                },
+                "birthDate": {
+                    "type": "string"
+                },
+                "buildingId": {
+                    "type": "string"
+                },
+                "emergencyContacts": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "$ref": "#/definitions/dto.EmergencyContactRequest"
+                    }
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "MALE",
+                        "FEMALE"
+                    ]
+                },
                 "grade": {
                     "type": "integer",
                     "maximum": 3,
                     "minimum": 1
                 },
+                "homeroomClass": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "medicalNotes": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
                 "name": {
                     "type": "string"
                 },
+                "phone": {
+                    "type": "string"
+                },
                 "roomNumber": {
                     "type": "string"
                 },
@@ -2503,8 +8145,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "password": {
-                    "type": "string",
-                    "minLength": 6
+                    "type": "string"
                 },
                 "role": {
                     "type": "string",
@@ -2519,24 +8160,36 @@ const docTemplate = `{
         "dto.UserResponse": {
             "type": "object",
             "properties": {
+                "deactivatedAt": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "mustChangePassword": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
                 "role": {
                     "type": "string"
+                },
+                "serviceAccount": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         }
     },
     "securityDefinitions": {
         "BearerAuth": {
-            "description": "JWT 토큰 (Bearer {token})",
+            "description": "JWT 토큰 (Bearer {token}) 또는 API 키 (ApiKey {key})",
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
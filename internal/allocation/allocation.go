@@ -0,0 +1,340 @@
+package allocation
+
+import (
+	"sort"
+
+	"dormi-api/internal/model"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ReasonMissingGender = "student gender is not set"
+	ReasonFixedBed      = "fixed bed is not available"
+	ReasonFixedGender   = "fixed bed is in a room for a different gender"
+	ReasonNoVacancy     = "no vacant bed available"
+)
+
+type Room struct {
+	ID        uuid.UUID
+	Gender    model.Gender
+	Beds      []uuid.UUID
+	Residents []Student
+}
+
+type Student struct {
+	ID            uuid.UUID
+	Grade         int
+	Gender        model.Gender
+	CurrentRoomID *uuid.UUID
+	CurrentBedID  *uuid.UUID
+}
+
+type Options struct {
+	GroupByGrade     bool
+	KeepCurrentRoom  bool
+	RoommateRequests []model.RoommateRequest
+	Fixed            map[uuid.UUID]uuid.UUID
+}
+
+type Assignment struct {
+	StudentID uuid.UUID
+	RoomID    uuid.UUID
+	BedID     uuid.UUID
+	Fixed     bool
+}
+
+type Unassigned struct {
+	StudentID uuid.UUID
+	Reason    string
+}
+
+type Result struct {
+	Assignments []Assignment
+	Unassigned  []Unassigned
+}
+
+type roomState struct {
+	id     uuid.UUID
+	order  int
+	gender model.Gender
+	fixed  bool
+	free   []uuid.UUID
+	grades map[int]int
+}
+
+func (r *roomState) accepts(gender model.Gender) bool {
+	return r.gender == "" || r.gender == gender
+}
+
+func (r *roomState) take(bedID uuid.UUID) {
+	for i, id := range r.free {
+		if id == bedID {
+			r.free = append(r.free[:i], r.free[i+1:]...)
+			return
+		}
+	}
+}
+
+type allocator struct {
+	opts    Options
+	rooms   []*roomState
+	byRoom  map[uuid.UUID]*roomState
+	byBed   map[uuid.UUID]*roomState
+	placed  map[uuid.UUID]*roomState
+	result  Result
+	pending map[uuid.UUID]bool
+}
+
+func Allocate(rooms []Room, students []Student, opts Options) Result {
+	a := &allocator{
+		opts:    opts,
+		byRoom:  map[uuid.UUID]*roomState{},
+		byBed:   map[uuid.UUID]*roomState{},
+		placed:  map[uuid.UUID]*roomState{},
+		pending: map[uuid.UUID]bool{},
+	}
+
+	for i, room := range rooms {
+		state := &roomState{
+			id:     room.ID,
+			order:  i,
+			gender: room.Gender,
+			fixed:  room.Gender != "",
+			free:   append([]uuid.UUID(nil), room.Beds...),
+			grades: map[int]int{},
+		}
+		for _, resident := range room.Residents {
+			if state.gender == "" {
+				state.gender = resident.Gender
+			}
+			state.grades[resident.Grade]++
+		}
+		a.rooms = append(a.rooms, state)
+		a.byRoom[room.ID] = state
+		for _, bedID := range room.Beds {
+			a.byBed[bedID] = state
+		}
+	}
+
+	var candidates []Student
+	for _, s := range students {
+		if s.Gender == "" {
+			a.unassign(s.ID, ReasonMissingGender)
+			continue
+		}
+		candidates = append(candidates, s)
+		a.pending[s.ID] = true
+	}
+
+	a.placeFixed(candidates)
+	if opts.KeepCurrentRoom {
+		a.placeCurrent(candidates)
+	}
+	a.placeGroups(candidates)
+
+	return a.result
+}
+
+func (a *allocator) placeFixed(students []Student) {
+	for _, s := range students {
+		bedID, ok := a.opts.Fixed[s.ID]
+		if !ok {
+			continue
+		}
+		room := a.byBed[bedID]
+		switch {
+		case room == nil || !containsBed(room.free, bedID):
+			a.unassign(s.ID, ReasonFixedBed)
+		case !room.accepts(s.Gender):
+			a.unassign(s.ID, ReasonFixedGender)
+		default:
+			a.place(s, room, bedID, true)
+		}
+	}
+}
+
+func (a *allocator) placeCurrent(students []Student) {
+	for _, s := range students {
+		if !a.pending[s.ID] || s.CurrentRoomID == nil {
+			continue
+		}
+		room := a.byRoom[*s.CurrentRoomID]
+		if room == nil || len(room.free) == 0 || !room.accepts(s.Gender) {
+			continue
+		}
+		bedID := room.free[0]
+		if s.CurrentBedID != nil && containsBed(room.free, *s.CurrentBedID) {
+			bedID = *s.CurrentBedID
+		}
+		a.place(s, room, bedID, false)
+	}
+}
+
+func (a *allocator) placeGroups(students []Student) {
+	groups := a.groups(students)
+
+	var units [][]Student
+	for _, group := range groups {
+		var rest []Student
+		for _, s := range group {
+			if !a.pending[s.ID] {
+				continue
+			}
+			if room := a.anchor(group, s); room != nil {
+				a.place(s, room, room.free[0], false)
+				continue
+			}
+			rest = append(rest, s)
+		}
+		if len(rest) > 0 {
+			units = append(units, rest)
+		}
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		if len(units[i]) != len(units[j]) {
+			return len(units[i]) > len(units[j])
+		}
+		return units[i][0].Grade < units[j][0].Grade
+	})
+
+	for _, unit := range units {
+		if room := a.bestRoom(unit); room != nil {
+			for _, s := range unit {
+				a.place(s, room, room.free[0], false)
+			}
+			continue
+		}
+		for _, s := range unit {
+			if room := a.bestRoom([]Student{s}); room != nil {
+				a.place(s, room, room.free[0], false)
+				continue
+			}
+			a.unassign(s.ID, ReasonNoVacancy)
+		}
+	}
+}
+
+func (a *allocator) groups(students []Student) [][]Student {
+	index := map[uuid.UUID]int{}
+	for i, s := range students {
+		index[s.ID] = i
+	}
+
+	parent := make([]int, len(students))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for _, req := range a.opts.RoommateRequests {
+		i, iok := index[req.StudentID]
+		j, jok := index[req.RoommateID]
+		if !iok || !jok || students[i].Gender != students[j].Gender {
+			continue
+		}
+		parent[find(i)] = find(j)
+	}
+
+	var order []int
+	members := map[int][]Student{}
+	for i, s := range students {
+		root := find(i)
+		if _, seen := members[root]; !seen {
+			order = append(order, root)
+		}
+		members[root] = append(members[root], s)
+	}
+
+	groups := make([][]Student, 0, len(order))
+	for _, root := range order {
+		group := members[root]
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Grade < group[j].Grade
+		})
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+func (a *allocator) anchor(group []Student, s Student) *roomState {
+	for _, member := range group {
+		room := a.placed[member.ID]
+		if room != nil && len(room.free) > 0 && room.accepts(s.Gender) {
+			return room
+		}
+	}
+	return nil
+}
+
+func (a *allocator) bestRoom(unit []Student) *roomState {
+	gender := unit[0].Gender
+	grade := unit[0].Grade
+
+	var best *roomState
+	bestScore := 0
+	for _, room := range a.rooms {
+		if len(room.free) < len(unit) || !room.accepts(gender) {
+			continue
+		}
+
+		score := len(room.free) - len(unit)
+		if !room.fixed {
+			score += 5
+		}
+		if a.opts.GroupByGrade {
+			if len(room.grades) == 0 {
+				score += 10
+			}
+			for g := range room.grades {
+				if g != grade {
+					score += 100
+					break
+				}
+			}
+		}
+
+		if best == nil || score < bestScore || (score == bestScore && room.order < best.order) {
+			best = room
+			bestScore = score
+		}
+	}
+	return best
+}
+
+func (a *allocator) place(s Student, room *roomState, bedID uuid.UUID, fixed bool) {
+	room.take(bedID)
+	if room.gender == "" {
+		room.gender = s.Gender
+	}
+	room.grades[s.Grade]++
+	a.placed[s.ID] = room
+	delete(a.pending, s.ID)
+	a.result.Assignments = append(a.result.Assignments, Assignment{
+		StudentID: s.ID,
+		RoomID:    room.id,
+		BedID:     bedID,
+		Fixed:     fixed,
+	})
+}
+
+func (a *allocator) unassign(studentID uuid.UUID, reason string) {
+	delete(a.pending, studentID)
+	a.result.Unassigned = append(a.result.Unassigned, Unassigned{StudentID: studentID, Reason: reason})
+}
+
+func containsBed(beds []uuid.UUID, bedID uuid.UUID) bool {
+	for _, id := range beds {
+		if id == bedID {
+			return true
+		}
+	}
+	return false
+}
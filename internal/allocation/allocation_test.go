@@ -0,0 +1,263 @@
+package allocation
+
+import (
+	"testing"
+
+	"dormi-api/internal/model"
+
+	"github.com/google/uuid"
+)
+
+func id(name string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(name))
+}
+
+func room(name string, gender model.Gender, beds int, residents ...Student) Room {
+	r := Room{ID: id(name), Gender: gender, Residents: residents}
+	for i := 0; i < beds; i++ {
+		r.Beds = append(r.Beds, bed(name, i))
+	}
+	return r
+}
+
+func bed(roomName string, i int) uuid.UUID {
+	return id(roomName + "/" + string(rune('a'+i)))
+}
+
+func student(name string, gender model.Gender, grade int) Student {
+	return Student{ID: id(name), Gender: gender, Grade: grade}
+}
+
+func TestAllocate(t *testing.T) {
+	male, female := model.GenderMale, model.GenderFemale
+	current := student("current", male, 1)
+	current.CurrentRoomID = ptr(id("B"))
+	current.CurrentBedID = ptr(bed("B", 1))
+
+	tests := []struct {
+		name           string
+		rooms          []Room
+		students       []Student
+		opts           Options
+		wantRooms      map[string]string
+		wantBeds       map[string]uuid.UUID
+		wantFixed      []string
+		wantUnassigned map[string]string
+	}{
+		{
+			name:     "places students only in rooms of their gender",
+			rooms:    []Room{room("A", female, 2), room("B", male, 2)},
+			students: []Student{student("m1", male, 1), student("f1", female, 1)},
+			wantRooms: map[string]string{
+				"m1": "B",
+				"f1": "A",
+			},
+		},
+		{
+			name:     "room without gender takes the gender of its first student",
+			rooms:    []Room{room("A", "", 2)},
+			students: []Student{student("m1", male, 1), student("f1", female, 1)},
+			wantRooms: map[string]string{
+				"m1": "A",
+			},
+			wantUnassigned: map[string]string{
+				"f1": ReasonNoVacancy,
+			},
+		},
+		{
+			name:      "existing residents set the room gender",
+			rooms:     []Room{room("A", "", 2, student("resident", female, 2)), room("B", "", 2)},
+			students:  []Student{student("m1", male, 1)},
+			wantRooms: map[string]string{"m1": "B"},
+		},
+		{
+			name:     "students without gender are not placed",
+			rooms:    []Room{room("A", "", 2)},
+			students: []Student{student("x", "", 1)},
+			wantUnassigned: map[string]string{
+				"x": ReasonMissingGender,
+			},
+		},
+		{
+			name:      "fixed bed is honoured",
+			rooms:     []Room{room("A", "", 2), room("B", "", 2)},
+			students:  []Student{student("s1", male, 1)},
+			opts:      Options{Fixed: map[uuid.UUID]uuid.UUID{id("s1"): bed("B", 1)}},
+			wantRooms: map[string]string{"s1": "B"},
+			wantBeds:  map[string]uuid.UUID{"s1": bed("B", 1)},
+			wantFixed: []string{"s1"},
+		},
+		{
+			name:     "fixed bed in a room for another gender",
+			rooms:    []Room{room("A", female, 2)},
+			students: []Student{student("s1", male, 1)},
+			opts:     Options{Fixed: map[uuid.UUID]uuid.UUID{id("s1"): bed("A", 0)}},
+			wantUnassigned: map[string]string{
+				"s1": ReasonFixedGender,
+			},
+		},
+		{
+			name:     "fixed bed already taken or unknown",
+			rooms:    []Room{room("A", "", 2)},
+			students: []Student{student("s1", male, 1), student("s2", male, 1), student("s3", male, 1)},
+			opts: Options{Fixed: map[uuid.UUID]uuid.UUID{
+				id("s1"): bed("A", 0),
+				id("s2"): bed("A", 0),
+				id("s3"): id("missing"),
+			}},
+			wantRooms: map[string]string{"s1": "A"},
+			wantFixed: []string{"s1"},
+			wantUnassigned: map[string]string{
+				"s2": ReasonFixedBed,
+				"s3": ReasonFixedBed,
+			},
+		},
+		{
+			name:     "roommate requests share a room",
+			rooms:    []Room{room("A", "", 2), room("B", "", 2), room("C", "", 2)},
+			students: []Student{student("s1", male, 1), student("s2", male, 1), student("s3", male, 1)},
+			opts: Options{RoommateRequests: []model.RoommateRequest{
+				{StudentID: id("s1"), RoommateID: id("s3")},
+			}},
+			wantRooms: map[string]string{
+				"s1": "A",
+				"s3": "A",
+				"s2": "B",
+			},
+		},
+		{
+			name:     "roommate requests across genders are ignored",
+			rooms:    []Room{room("A", "", 2), room("B", "", 2)},
+			students: []Student{student("m1", male, 1), student("f1", female, 1)},
+			opts: Options{RoommateRequests: []model.RoommateRequest{
+				{StudentID: id("m1"), RoommateID: id("f1")},
+			}},
+			wantRooms: map[string]string{
+				"m1": "A",
+				"f1": "B",
+			},
+		},
+		{
+			name:     "roommate joins a partner with a fixed bed",
+			rooms:    []Room{room("A", "", 2), room("B", "", 2)},
+			students: []Student{student("s1", male, 1), student("s2", male, 1)},
+			opts: Options{
+				Fixed:            map[uuid.UUID]uuid.UUID{id("s1"): bed("B", 0)},
+				RoommateRequests: []model.RoommateRequest{{StudentID: id("s1"), RoommateID: id("s2")}},
+			},
+			wantRooms: map[string]string{"s1": "B", "s2": "B"},
+			wantFixed: []string{"s1"},
+		},
+		{
+			name:     "without grade grouping rooms are filled in order",
+			rooms:    []Room{room("A", "", 3), room("B", "", 3)},
+			students: []Student{student("g1", male, 1), student("g2", male, 2)},
+			wantRooms: map[string]string{
+				"g1": "A",
+				"g2": "A",
+			},
+		},
+		{
+			name:     "grade grouping separates grades",
+			rooms:    []Room{room("A", "", 3), room("B", "", 3)},
+			students: []Student{student("g1", male, 1), student("g2", male, 2), student("g1b", male, 1)},
+			opts:     Options{GroupByGrade: true},
+			wantRooms: map[string]string{
+				"g1":  "A",
+				"g1b": "A",
+				"g2":  "B",
+			},
+		},
+		{
+			name:      "grade grouping avoids rooms with residents of another grade",
+			rooms:     []Room{room("A", "", 3, student("resident", male, 2)), room("B", "", 3)},
+			students:  []Student{student("g1", male, 1)},
+			opts:      Options{GroupByGrade: true},
+			wantRooms: map[string]string{"g1": "B"},
+		},
+		{
+			name:      "current room and bed are kept",
+			rooms:     []Room{room("A", "", 2), room("B", "", 2)},
+			students:  []Student{current},
+			opts:      Options{KeepCurrentRoom: true},
+			wantRooms: map[string]string{"current": "B"},
+			wantBeds:  map[string]uuid.UUID{"current": bed("B", 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Allocate(tt.rooms, tt.students, tt.opts)
+
+			rooms := map[uuid.UUID]string{}
+			for _, r := range tt.rooms {
+				for _, name := range []string{"A", "B", "C"} {
+					if r.ID == id(name) {
+						rooms[r.ID] = name
+					}
+				}
+			}
+
+			assigned := map[uuid.UUID]Assignment{}
+			beds := map[uuid.UUID]bool{}
+			for _, a := range result.Assignments {
+				if beds[a.BedID] {
+					t.Fatalf("bed %s assigned twice", a.BedID)
+				}
+				beds[a.BedID] = true
+				assigned[a.StudentID] = a
+			}
+
+			if len(result.Assignments) != len(tt.wantRooms) {
+				t.Errorf("got %d assignments, want %d", len(result.Assignments), len(tt.wantRooms))
+			}
+			for name, wantRoom := range tt.wantRooms {
+				a, ok := assigned[id(name)]
+				if !ok {
+					t.Errorf("%s: not assigned, want room %s", name, wantRoom)
+					continue
+				}
+				if got := rooms[a.RoomID]; got != wantRoom {
+					t.Errorf("%s: got room %s, want %s", name, got, wantRoom)
+				}
+			}
+			for name, wantBed := range tt.wantBeds {
+				if got := assigned[id(name)].BedID; got != wantBed {
+					t.Errorf("%s: got bed %s, want %s", name, got, wantBed)
+				}
+			}
+			for name, a := range assigned {
+				want := false
+				for _, fixed := range tt.wantFixed {
+					want = want || id(fixed) == name
+				}
+				if a.Fixed != want {
+					t.Errorf("%s: got fixed %v, want %v", name, a.Fixed, want)
+				}
+			}
+
+			if len(result.Unassigned) != len(tt.wantUnassigned) {
+				t.Errorf("got %d unassigned, want %d", len(result.Unassigned), len(tt.wantUnassigned))
+			}
+			for _, u := range result.Unassigned {
+				var name string
+				for n := range tt.wantUnassigned {
+					if id(n) == u.StudentID {
+						name = n
+					}
+				}
+				if name == "" {
+					t.Errorf("unexpected unassigned student %s: %s", u.StudentID, u.Reason)
+					continue
+				}
+				if u.Reason != tt.wantUnassigned[name] {
+					t.Errorf("%s: got reason %q, want %q", name, u.Reason, tt.wantUnassigned[name])
+				}
+			}
+		})
+	}
+}
+
+func ptr(v uuid.UUID) *uuid.UUID {
+	return &v
+}
@@ -2,17 +2,69 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+type OIDCProvider struct {
+	Name           string
+	Issuer         string
+	ClientID       string
+	ClientSecret   string
+	RedirectURL    string
+	Scopes         []string
+	AllowedDomains []string
+}
+
 type Config struct {
-	DBHost        string
-	DBPort        string
-	DBUser        string
-	DBPassword    string
-	DBName        string
-	JWTSecret     string
+	DBHost              string
+	DBPort              string
+	DBUser              string
+	DBPassword          string
+	DBName              string
+	JWTIssuer           string
+	JWTSigningAlgorithm string
+	AccessTokenTTL      time.Duration
+	RefreshTokenTTL     time.Duration
+	ImpersonationTTL    time.Duration
+
+	LoginMaxFailures   int
+	LoginIPMaxFailures int
+	LoginIPDelay       time.Duration
+	LoginLockoutBase   time.Duration
+	LoginLockoutMax    time.Duration
+	TrustedProxies     []string
+
+	TOTPIssuer       string
+	MFARequiredRoles []string
+
+	MailDriver       string
+	SMTPHost         string
+	SMTPPort         string
+	SMTPUsername     string
+	SMTPPassword     string
+	MailFrom         string
+	PasswordResetURL string
+	PasswordResetTTL time.Duration
+	EmailChangeURL   string
+	EmailChangeTTL   time.Duration
+
+	PasswordMinLength       int
+	PasswordRequiredClasses []string
+	PasswordHistorySize     int
+
+	CouncilDailyProposalLimit int
+
+	StudentPurgePoints string
+
+	OIDCProviders      []OIDCProvider
+	OIDCAuthRequestTTL time.Duration
+	OIDCCookieSecure   bool
+	CORSAllowedOrigins []string
+
 	ServerPort    string
 	AdminEmail    string
 	AdminPassword string
@@ -22,14 +74,128 @@ func Load() *Config {
 	godotenv.Load()
 
 	return &Config{
-		DBHost:        os.Getenv("DB_HOST"),
-		DBPort:        os.Getenv("DB_PORT"),
-		DBUser:        os.Getenv("DB_USER"),
-		DBPassword:    os.Getenv("DB_PASSWORD"),
-		DBName:        os.Getenv("DB_NAME"),
-		JWTSecret:     os.Getenv("JWT_SECRET"),
+		DBHost:              os.Getenv("DB_HOST"),
+		DBPort:              os.Getenv("DB_PORT"),
+		DBUser:              os.Getenv("DB_USER"),
+		DBPassword:          os.Getenv("DB_PASSWORD"),
+		DBName:              os.Getenv("DB_NAME"),
+		JWTIssuer:           getString("JWT_ISSUER", "dormi-api"),
+		JWTSigningAlgorithm: getString("JWT_SIGNING_ALGORITHM", "EdDSA"),
+		AccessTokenTTL:      getDuration("ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL:     getDuration("REFRESH_TOKEN_TTL", 14*24*time.Hour),
+		ImpersonationTTL:    getDuration("IMPERSONATION_TTL", 30*time.Minute),
+
+		LoginMaxFailures:   getInt("LOGIN_MAX_FAILURES", 5),
+		LoginIPMaxFailures: getInt("LOGIN_IP_MAX_FAILURES", 20),
+		LoginIPDelay:       getDuration("LOGIN_IP_DELAY", 2*time.Second),
+		LoginLockoutBase:   getDuration("LOGIN_LOCKOUT_BASE", time.Minute),
+		LoginLockoutMax:    getDuration("LOGIN_LOCKOUT_MAX", time.Hour),
+		TrustedProxies:     getList("TRUSTED_PROXIES"),
+
+		TOTPIssuer:       getString("TOTP_ISSUER", "Dormi"),
+		MFARequiredRoles: getList("MFA_REQUIRED_ROLES"),
+
+		MailDriver:       getString("MAIL_DRIVER", ""),
+		SMTPHost:         os.Getenv("SMTP_HOST"),
+		SMTPPort:         getString("SMTP_PORT", "587"),
+		SMTPUsername:     os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:     os.Getenv("SMTP_PASSWORD"),
+		MailFrom:         getString("MAIL_FROM", "no-reply@dormi.local"),
+		PasswordResetURL: getString("PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+		PasswordResetTTL: getDuration("PASSWORD_RESET_TTL", 30*time.Minute),
+		EmailChangeURL:   getString("EMAIL_CHANGE_URL", "http://localhost:3000/confirm-email"),
+		EmailChangeTTL:   getDuration("EMAIL_CHANGE_TTL", 24*time.Hour),
+
+		PasswordMinLength:       getInt("PASSWORD_MIN_LENGTH", 8),
+		PasswordRequiredClasses: getList("PASSWORD_REQUIRED_CLASSES"),
+		PasswordHistorySize:     getInt("PASSWORD_HISTORY_SIZE", 5),
+
+		CouncilDailyProposalLimit: getInt("COUNCIL_DAILY_PROPOSAL_LIMIT", 10),
+
+		StudentPurgePoints: getString("STUDENT_PURGE_POINTS", "delete"),
+
+		OIDCProviders:      loadOIDCProviders(),
+		OIDCAuthRequestTTL: getDuration("OIDC_AUTH_REQUEST_TTL", 10*time.Minute),
+		OIDCCookieSecure:   getBool("OIDC_COOKIE_SECURE", true),
+		CORSAllowedOrigins: getList("CORS_ALLOWED_ORIGINS"),
+
 		ServerPort:    os.Getenv("SERVER_PORT"),
 		AdminEmail:    os.Getenv("ADMIN_EMAIL"),
 		AdminPassword: os.Getenv("ADMIN_PASSWORD"),
 	}
 }
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+func getInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
+
+func getBool(key string, fallback bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fallback
+	}
+	return b
+}
+
+func getString(key, fallback string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func getList(key string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func loadOIDCProviders() []OIDCProvider {
+	var providers []OIDCProvider
+	for _, name := range getList("OIDC_PROVIDERS") {
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		scopes := getList(prefix + "SCOPES")
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "email", "profile"}
+		}
+		providers = append(providers, OIDCProvider{
+			Name:           strings.ToLower(name),
+			Issuer:         os.Getenv(prefix + "ISSUER"),
+			ClientID:       os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret:   os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:    os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:         scopes,
+			AllowedDomains: getList(prefix + "ALLOWED_DOMAINS"),
+		})
+	}
+	return providers
+}
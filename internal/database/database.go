@@ -1,13 +1,18 @@
 package database
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"dormi-api/internal/config"
 	"dormi-api/internal/model"
 
+	"github.com/google/uuid"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
@@ -28,13 +33,215 @@ func Connect(cfg *config.Config) (*gorm.DB, error) {
 }
 
 func Migrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if db.Migrator().HasTable(&model.Student{}) && db.Migrator().HasIndex(&model.Student{}, "idx_students_student_number") {
+		if err := db.Migrator().DropIndex(&model.Student{}, "idx_students_student_number"); err != nil {
+			return err
+		}
+	}
+
+	err := db.AutoMigrate(
 		&model.User{},
+		&model.Building{},
+		&model.Floor{},
+		&model.Room{},
+		&model.Bed{},
 		&model.Student{},
 		&model.PointReason{},
 		&model.Point{},
 		&model.Duty{},
 		&model.DutySwapRequest{},
 		&model.AuditLog{},
+		&model.Session{},
+		&model.RefreshToken{},
+		&model.LoginAttempt{},
+		&model.RecoveryCode{},
+		&model.PasswordResetToken{},
+		&model.EmailChangeToken{},
+		&model.RolePermission{},
+		&model.SeededPermission{},
+		&model.AppliedMigration{},
+		&model.PointProposal{},
+		&model.APIKey{},
+		&model.OIDCAuthRequest{},
+		&model.SigningKey{},
+		&model.PasswordHistory{},
+		&model.Guardian{},
+		&model.RoomAssignment{},
+		&model.AllocationPlan{},
+		&model.AllocationItem{},
 	)
+	if err != nil {
+		return err
+	}
+
+	return runOnce(db, "backfill_beds", backfillBeds)
+}
+
+func runOnce(db *gorm.DB, name string, migrate func(tx *gorm.DB) error) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.AppliedMigration{Name: name})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return migrate(tx)
+	})
+}
+
+const legacyBuildingName = "기존 호실"
+
+func backfillBeds(tx *gorm.DB) error {
+	var students []model.Student
+	err := tx.Where("bed_id IS NULL AND room_number <> ''").
+		Order("room_number, student_number").
+		Find(&students).Error
+	if err != nil || len(students) == 0 {
+		return err
+	}
+
+	var numbers []string
+	byRoom := map[string][]model.Student{}
+	for _, student := range students {
+		if _, ok := byRoom[student.RoomNumber]; !ok {
+			numbers = append(numbers, student.RoomNumber)
+		}
+		byRoom[student.RoomNumber] = append(byRoom[student.RoomNumber], student)
+	}
+
+	assigned, rooms := 0, 0
+	for _, number := range numbers {
+		residents := byRoom[number]
+
+		room, err := legacyRoom(tx, number, residents)
+		if err != nil {
+			return err
+		}
+		if room == nil {
+			continue
+		}
+
+		var occupied []uuid.UUID
+		err = tx.Model(&model.Student{}).
+			Where("bed_id IN (?)", tx.Model(&model.Bed{}).Select("id").Where("room_id = ?", room.ID)).
+			Pluck("bed_id", &occupied).Error
+		if err != nil {
+			return err
+		}
+		taken := map[uuid.UUID]bool{}
+		for _, id := range occupied {
+			taken[id] = true
+		}
+
+		var free []model.Bed
+		highest := 0
+		for _, bed := range room.Beds {
+			if n, err := strconv.Atoi(bed.Label); err == nil && n > highest {
+				highest = n
+			}
+			if !taken[bed.ID] {
+				free = append(free, bed)
+			}
+		}
+
+		for i, student := range residents {
+			var bed model.Bed
+			if i < len(free) {
+				bed = free[i]
+			} else {
+				highest++
+				bed = model.Bed{RoomID: room.ID, Label: strconv.Itoa(highest)}
+				if err := tx.Create(&bed).Error; err != nil {
+					return err
+				}
+				room.Capacity++
+			}
+
+			if err := tx.Model(&model.Student{}).Where("id = ?", student.ID).Update("bed_id", bed.ID).Error; err != nil {
+				return err
+			}
+			err := tx.Create(&model.RoomAssignment{
+				StudentID:     &student.ID,
+				StudentNumber: student.StudentNumber,
+				StudentName:   student.Name,
+				RoomID:        &room.ID,
+				BedID:         &bed.ID,
+				RoomNumber:    room.Number,
+				BedLabel:      bed.Label,
+				StartedAt:     student.CreatedAt,
+				Reason:        "backfill",
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&model.Room{}).Where("id = ?", room.ID).Update("capacity", room.Capacity).Error; err != nil {
+			return err
+		}
+		assigned += len(residents)
+		rooms++
+	}
+
+	if assigned == 0 {
+		return nil
+	}
+
+	details, err := json.Marshal(map[string]int{"students": assigned, "rooms": rooms})
+	if err != nil {
+		return err
+	}
+	return tx.Create(&model.AuditLog{
+		Action:     model.AuditActionBackfillBeds,
+		EntityType: "student",
+		Details:    details,
+	}).Error
+}
+
+func legacyRoom(tx *gorm.DB, number string, residents []model.Student) (*model.Room, error) {
+	var rooms []model.Room
+	err := tx.Preload("Beds", func(db *gorm.DB) *gorm.DB {
+		return db.Order("LENGTH(label), label")
+	}).Where("number = ?", number).Find(&rooms).Error
+	if err != nil {
+		return nil, err
+	}
+	switch len(rooms) {
+	case 1:
+		return &rooms[0], nil
+	case 0:
+	default:
+		return nil, nil
+	}
+
+	building := model.Building{Name: legacyBuildingName}
+	if err := tx.Where("name = ?", building.Name).FirstOrCreate(&building).Error; err != nil {
+		return nil, err
+	}
+
+	floorNumber := 1
+	digits := strings.TrimLeftFunc(number, func(r rune) bool { return r < '0' || r > '9' })
+	if end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+		digits = digits[:end]
+	}
+	if n, err := strconv.Atoi(digits); err == nil && n >= 100 {
+		floorNumber = n / 100
+	}
+
+	floor := model.Floor{BuildingID: building.ID, Number: floorNumber}
+	if err := tx.Where("building_id = ? AND number = ?", floor.BuildingID, floor.Number).FirstOrCreate(&floor).Error; err != nil {
+		return nil, err
+	}
+
+	gender := residents[0].Gender
+	for _, student := range residents {
+		if student.Gender != gender {
+			gender = ""
+			break
+		}
+	}
+
+	room := model.Room{FloorID: floor.ID, Number: number, Gender: gender}
+	if err := tx.Create(&room).Error; err != nil {
+		return nil, err
+	}
+	return &room, nil
 }
@@ -11,43 +11,222 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+type MFALoginRequest struct {
+	MFAToken     string `json:"mfaToken" binding:"required"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recoveryCode"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type OIDCCallbackRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+type PasswordResetRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type PasswordResetConfirmRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"newPassword" binding:"required"`
+}
+
 type CreateUserRequest struct {
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=6"`
+	Password string `json:"password" binding:"required"`
 	Name     string `json:"name" binding:"required"`
 	Role     string `json:"role" binding:"required,oneof=ADMIN SUPERVISOR COUNCIL"`
 }
 
 type UpdateUserRequest struct {
 	Email    string `json:"email" binding:"omitempty,email"`
-	Password string `json:"password" binding:"omitempty,min=6"`
+	Password string `json:"password"`
 	Name     string `json:"name"`
 	Role     string `json:"role" binding:"omitempty,oneof=ADMIN SUPERVISOR COUNCIL"`
 }
 
+type UpdateProfileRequest struct {
+	Name string `json:"name"`
+}
+
+type EmailChangeRequest struct {
+	NewEmail        string `json:"newEmail" binding:"required,email"`
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+}
+
+type EmailChangeConfirmRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
 type ChangePasswordRequest struct {
 	CurrentPassword string `json:"currentPassword" binding:"required"`
-	NewPassword     string `json:"newPassword" binding:"required,min=6"`
+	NewPassword     string `json:"newPassword" binding:"required"`
+}
+
+type UpdateRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+type EmergencyContactRequest struct {
+	Name         string `json:"name" binding:"required,max=100"`
+	Relationship string `json:"relationship" binding:"max=30"`
+	Phone        string `json:"phone" binding:"required"`
 }
 
 type CreateStudentRequest struct {
-	StudentNumber string `json:"studentNumber" binding:"required"`
-	Name          string `json:"name" binding:"required"`
-	RoomNumber    string `json:"roomNumber" binding:"required"`
-	Grade         int    `json:"grade" binding:"required,min=1,max=3"`
+	StudentNumber     string                    `json:"studentNumber" binding:"required"`
+	Name              string                    `json:"name" binding:"required"`
+	BuildingID        uuid.UUID                 `json:"buildingId"`
+	RoomNumber        string                    `json:"roomNumber"`
+	BedID             *uuid.UUID                `json:"bedId"`
+	Grade             int                       `json:"grade" binding:"required,min=1,max=3"`
+	Gender            string                    `json:"gender" binding:"omitempty,oneof=MALE FEMALE"`
+	HomeroomClass     string                    `json:"homeroomClass" binding:"max=20"`
+	BirthDate         string                    `json:"birthDate" binding:"omitempty,datetime=2006-01-02"`
+	Phone             string                    `json:"phone"`
+	MedicalNotes      string                    `json:"medicalNotes" binding:"max=2000"`
+	EmergencyContacts []EmergencyContactRequest `json:"emergencyContacts" binding:"max=5,dive"`
 }
 
 type UpdateStudentRequest struct {
-	StudentNumber string `json:"studentNumber"`
-	Name          string `json:"name"`
-	RoomNumber    string `json:"roomNumber"`
-	Grade         int    `json:"grade" binding:"omitempty,min=1,max=3"`
+	StudentNumber     string                     `json:"studentNumber"`
+	Name              string                     `json:"name"`
+	BuildingID        uuid.UUID                  `json:"buildingId"`
+	RoomNumber        string                     `json:"roomNumber"`
+	BedID             *uuid.UUID                 `json:"bedId"`
+	Grade             int                        `json:"grade" binding:"omitempty,min=1,max=3"`
+	Gender            string                     `json:"gender" binding:"omitempty,oneof=MALE FEMALE"`
+	HomeroomClass     string                     `json:"homeroomClass" binding:"max=20"`
+	BirthDate         *string                    `json:"birthDate"`
+	Phone             *string                    `json:"phone"`
+	MedicalNotes      *string                    `json:"medicalNotes" binding:"omitempty,max=2000"`
+	EmergencyContacts *[]EmergencyContactRequest `json:"emergencyContacts" binding:"omitempty,max=5,dive"`
+}
+
+type GuardianRequest struct {
+	Name           string `json:"name" binding:"required,max=100"`
+	Relationship   string `json:"relationship" binding:"required,max=30"`
+	Phone          string `json:"phone" binding:"required"`
+	Email          string `json:"email" binding:"omitempty,email"`
+	IsPrimary      bool   `json:"isPrimary"`
+	SMSConsent     bool   `json:"smsConsent"`
+	EmailConsent   bool   `json:"emailConsent"`
+	MedicalConsent bool   `json:"medicalConsent"`
+}
+
+type BuildingRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type CreateFloorRequest struct {
+	Number int `json:"number" binding:"required,min=1"`
+}
+
+type CreateRoomRequest struct {
+	FloorID  uuid.UUID `json:"floorId" binding:"required"`
+	Number   string    `json:"number" binding:"required,max=20"`
+	Capacity int       `json:"capacity" binding:"required,min=1,max=20"`
+	Gender   string    `json:"gender" binding:"omitempty,oneof=MALE FEMALE"`
+}
+
+type UpdateRoomRequest struct {
+	Number   string `json:"number" binding:"max=20"`
+	Capacity int    `json:"capacity" binding:"omitempty,min=1,max=20"`
+	Gender   string `json:"gender" binding:"omitempty,oneof=MALE FEMALE"`
+}
+
+type RoomQuery struct {
+	BuildingID uuid.UUID `form:"buildingId"`
+	Floor      *int      `form:"floor"`
+	Gender     string    `form:"gender" binding:"omitempty,oneof=MALE FEMALE"`
+	VacantOnly bool      `form:"vacantOnly"`
+}
+
+type RoomListQuery struct {
+	RoomQuery
+	PageQuery
+}
+
+type PointReasonQuery struct {
+	Type string `form:"type" binding:"omitempty,oneof=REWARD PENALTY"`
+	PageQuery
+}
+
+type MoveStudentRequest struct {
+	BuildingID uuid.UUID  `json:"buildingId"`
+	RoomNumber string     `json:"roomNumber"`
+	BedID      *uuid.UUID `json:"bedId"`
+	Reason     string     `json:"reason" binding:"required,max=255"`
+}
+
+type RoomResidentQuery struct {
+	BuildingID uuid.UUID `form:"buildingId"`
+	Room       string    `form:"room" binding:"required"`
+	Date       string    `form:"date" binding:"required,datetime=2006-01-02"`
+}
+
+type RoommateRequest struct {
+	StudentID  uuid.UUID `json:"studentId" binding:"required"`
+	RoommateID uuid.UUID `json:"roommateId" binding:"required"`
+}
+
+type FixedAssignmentRequest struct {
+	StudentID uuid.UUID `json:"studentId" binding:"required"`
+	BedID     uuid.UUID `json:"bedId" binding:"required"`
+}
+
+type CreateAllocationPlanRequest struct {
+	Name             string                   `json:"name" binding:"required,max=100"`
+	StudentIDs       []uuid.UUID              `json:"studentIds"`
+	GroupByGrade     bool                     `json:"groupByGrade"`
+	KeepCurrentRoom  bool                     `json:"keepCurrentRoom"`
+	RoommateRequests []RoommateRequest        `json:"roommateRequests" binding:"dive"`
+	FixedAssignments []FixedAssignmentRequest `json:"fixedAssignments" binding:"dive"`
+}
+
+type UpdateAllocationItemRequest struct {
+	BedID *uuid.UUID `json:"bedId"`
+}
+
+type ImportStudentsRequest struct {
+	DryRun bool   `form:"dryRun"`
+	Sheet  string `form:"sheet"`
+}
+
+type ExportStudentsQuery struct {
+	StudentQuery
+	Format string `form:"format" binding:"omitempty,oneof=xlsx csv"`
+}
+
+type RolloverRequest struct {
+	Checksum string `json:"checksum" binding:"required"`
+}
+
+type PageQuery struct {
+	Page   int    `form:"page,default=1" binding:"min=1"`
+	Limit  int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Cursor string `form:"cursor"`
+	Sort   string `form:"sort"`
 }
 
 type StudentQuery struct {
-	Search string `form:"search"`
-	Grade  int    `form:"grade"`
-	Room   string `form:"room"`
+	Search     string    `form:"search"`
+	Grade      int       `form:"grade"`
+	BuildingID uuid.UUID `form:"buildingId"`
+	Room       string    `form:"room"`
+}
+
+type StudentListQuery struct {
+	StudentQuery
+	PageQuery
 }
 
 type GivePointRequest struct {
@@ -60,37 +239,78 @@ type BulkGivePointRequest struct {
 	ReasonID   uuid.UUID   `json:"reasonId" binding:"required"`
 }
 
+type UserQuery struct {
+	Status         string `form:"status" binding:"omitempty,oneof=ACTIVE INACTIVE"`
+	Role           string `form:"role" binding:"omitempty,oneof=ADMIN SUPERVISOR COUNCIL"`
+	ServiceAccount *bool  `form:"-"`
+	PageQuery
+}
+
+type CreateServiceAccountRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+	Role string `json:"role" binding:"required,oneof=ADMIN SUPERVISOR COUNCIL"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type CreatePointProposalRequest struct {
+	StudentID uuid.UUID `json:"studentId" binding:"required"`
+	ReasonID  uuid.UUID `json:"reasonId" binding:"required"`
+	Note      string    `json:"note" binding:"max=500"`
+}
+
+type RejectPointProposalRequest struct {
+	Comment string `json:"comment" binding:"max=500"`
+}
+
+type PointProposalQuery struct {
+	Status     string    `form:"status" binding:"omitempty,oneof=PENDING APPROVED REJECTED"`
+	StudentID  uuid.UUID `form:"studentId"`
+	ProposedBy uuid.UUID `form:"proposedBy"`
+	PageQuery
+}
+
 type CreatePointReasonRequest struct {
-	Name  string `json:"name" binding:"required"`
-	Type  string `json:"type" binding:"required,oneof=REWARD PENALTY"`
-	Score int    `json:"score" binding:"required,min=1"`
+	Name           string `json:"name" binding:"required"`
+	Type           string `json:"type" binding:"required,oneof=REWARD PENALTY"`
+	Score          int    `json:"score" binding:"required,min=1"`
+	CouncilAllowed bool   `json:"councilAllowed"`
 }
 
 type UpdatePointReasonRequest struct {
-	Name  string `json:"name"`
-	Type  string `json:"type" binding:"omitempty,oneof=REWARD PENALTY"`
-	Score int    `json:"score" binding:"omitempty,min=1"`
+	Name           string `json:"name"`
+	Type           string `json:"type" binding:"omitempty,oneof=REWARD PENALTY"`
+	Score          int    `json:"score" binding:"omitempty,min=1"`
+	CouncilAllowed *bool  `json:"councilAllowed"`
 }
 
 type PointQuery struct {
-	StudentID uuid.UUID `form:"studentId"`
-	Type      string    `form:"type"`
-	StartDate string    `form:"startDate"`
-	EndDate   string    `form:"endDate"`
+	StudentID       uuid.UUID `form:"studentId"`
+	Type            string    `form:"type"`
+	StartDate       string    `form:"startDate"`
+	EndDate         string    `form:"endDate"`
+	IncludeArchived bool      `form:"includeArchived"`
+	PageQuery
 }
 
 type CreateDutyRequest struct {
-	Type       string    `json:"type" binding:"required,oneof=DORM NIGHT_STUDY"`
-	Date       string    `json:"date" binding:"required"`
-	Floor      *int      `json:"floor"`
-	AssigneeID uuid.UUID `json:"assigneeId" binding:"required"`
+	Type       string     `json:"type" binding:"required,oneof=DORM NIGHT_STUDY"`
+	Date       string     `json:"date" binding:"required"`
+	Floor      *int       `json:"floor"`
+	FloorID    *uuid.UUID `json:"floorId"`
+	AssigneeID uuid.UUID  `json:"assigneeId" binding:"required"`
 }
 
 type UpdateDutyRequest struct {
-	Type       string    `json:"type" binding:"omitempty,oneof=DORM NIGHT_STUDY"`
-	Date       string    `json:"date"`
-	Floor      *int      `json:"floor"`
-	AssigneeID uuid.UUID `json:"assigneeId"`
+	Type       string     `json:"type" binding:"omitempty,oneof=DORM NIGHT_STUDY"`
+	Date       string     `json:"date"`
+	Floor      *int       `json:"floor"`
+	FloorID    *uuid.UUID `json:"floorId"`
+	AssigneeID uuid.UUID  `json:"assigneeId"`
 }
 
 type GenerateDutyRequest struct {
@@ -99,6 +319,7 @@ type GenerateDutyRequest struct {
 	EndDate     string      `json:"endDate" binding:"required"`
 	AssigneeIDs []uuid.UUID `json:"assigneeIds" binding:"required,min=1"`
 	Floor       *int        `json:"floor"`
+	FloorID     *uuid.UUID  `json:"floorId"`
 }
 
 type CreateDutySwapRequest struct {
@@ -110,14 +331,15 @@ type DutyQuery struct {
 	AssigneeID uuid.UUID `form:"assigneeId"`
 	StartDate  string    `form:"startDate"`
 	EndDate    string    `form:"endDate"`
+	PageQuery
 }
 
 type AuditQuery struct {
-	UserID     uuid.UUID `form:"userId"`
-	Action     string    `form:"action"`
-	EntityType string    `form:"entityType"`
-	StartDate  time.Time `form:"startDate"`
-	EndDate    time.Time `form:"endDate"`
-	Page       int       `form:"page,default=1"`
-	Limit      int       `form:"limit,default=20"`
+	UserID         uuid.UUID `form:"userId"`
+	ImpersonatorID uuid.UUID `form:"impersonatorId"`
+	Action         string    `form:"action"`
+	EntityType     string    `form:"entityType"`
+	StartDate      time.Time `form:"startDate"`
+	EndDate        time.Time `form:"endDate"`
+	PageQuery
 }
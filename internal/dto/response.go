@@ -10,6 +10,7 @@ type Response struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
 	Error   string      `json:"error,omitempty"`
+	Details interface{} `json:"details,omitempty"`
 }
 
 type PaginatedResponse struct {
@@ -19,38 +20,297 @@ type PaginatedResponse struct {
 }
 
 type Pagination struct {
-	Page       int   `json:"page"`
-	Limit      int   `json:"limit"`
-	Total      int64 `json:"total"`
-	TotalPages int   `json:"totalPages"`
+	Page       int    `json:"page,omitempty"`
+	Limit      int    `json:"limit"`
+	Total      int64  `json:"total"`
+	TotalPages int    `json:"totalPages"`
+	Sort       string `json:"sort"`
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 type LoginResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+	Token                  string        `json:"token,omitempty"`
+	RefreshToken           string        `json:"refreshToken,omitempty"`
+	ExpiresAt              *time.Time    `json:"expiresAt,omitempty"`
+	MFARequired            bool          `json:"mfaRequired,omitempty"`
+	MFAToken               string        `json:"mfaToken,omitempty"`
+	MFAEnrollmentRequired  bool          `json:"mfaEnrollmentRequired,omitempty"`
+	PasswordChangeRequired bool          `json:"passwordChangeRequired,omitempty"`
+	User                   UserResponse  `json:"user"`
+	Impersonator           *UserResponse `json:"impersonator,omitempty"`
+}
+
+type SessionResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	AuthMethod     string     `json:"authMethod"`
+	ImpersonatorID *uuid.UUID `json:"impersonatorId,omitempty"`
+	UserAgent      string     `json:"userAgent"`
+	IPAddress      string     `json:"ipAddress"`
+	Current        bool       `json:"current"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	LastSeenAt     time.Time  `json:"lastSeenAt"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+}
+
+type SigningKeyResponse struct {
+	ID        string     `json:"id"`
+	Algorithm string     `json:"algorithm"`
+	Active    bool       `json:"active"`
+	RetiresAt *time.Time `json:"retiresAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+type JWKSResponse struct {
+	Keys []JWK `json:"keys"`
+}
+
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+	State            string `json:"state"`
+}
+
+type MFAStatusResponse struct {
+	Enabled                bool  `json:"enabled"`
+	Required               bool  `json:"required"`
+	RemainingRecoveryCodes int64 `json:"remainingRecoveryCodes"`
+}
+
+type MFASetupResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauthUrl"`
+}
+
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
 }
 
 type UserResponse struct {
-	ID    uuid.UUID `json:"id"`
-	Email string    `json:"email"`
-	Name  string    `json:"name"`
-	Role  string    `json:"role"`
+	ID                 uuid.UUID  `json:"id"`
+	Email              string     `json:"email"`
+	Name               string     `json:"name"`
+	Role               string     `json:"role"`
+	Status             string     `json:"status"`
+	DeactivatedAt      *time.Time `json:"deactivatedAt,omitempty"`
+	ServiceAccount     bool       `json:"serviceAccount"`
+	MustChangePassword bool       `json:"mustChangePassword"`
+}
+
+type APIKeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Key        string     `json:"key,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type RolePermissionsResponse struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+type MeResponse struct {
+	User                  UserResponse              `json:"user"`
+	Impersonator          *UserResponse             `json:"impersonator,omitempty"`
+	Permissions           []string                  `json:"permissions"`
+	UpcomingDuties        []DutyResponse            `json:"upcomingDuties"`
+	PendingSwapRequests   []DutySwapRequestResponse `json:"pendingSwapRequests"`
+	MyPendingSwapRequests []DutySwapRequestResponse `json:"myPendingSwapRequests"`
 }
 
 type StudentResponse struct {
-	ID            uuid.UUID `json:"id"`
+	ID            uuid.UUID               `json:"id"`
+	StudentNumber string                  `json:"studentNumber"`
+	Name          string                  `json:"name"`
+	RoomNumber    string                  `json:"roomNumber"`
+	BedID         *uuid.UUID              `json:"bedId,omitempty"`
+	Grade         int                     `json:"grade"`
+	Gender        string                  `json:"gender,omitempty"`
+	HomeroomClass string                  `json:"homeroomClass,omitempty"`
+	Status        string                  `json:"status"`
+	GraduatedAt   *time.Time              `json:"graduatedAt,omitempty"`
+	DeletedAt     *time.Time              `json:"deletedAt,omitempty"`
+	Profile       *StudentProfileResponse `json:"profile,omitempty"`
+	CreatedAt     time.Time               `json:"createdAt"`
+}
+
+type StudentImportResponse struct {
+	DryRun  bool                 `json:"dryRun"`
+	Created int                  `json:"created"`
+	Updated int                  `json:"updated"`
+	Skipped int                  `json:"skipped"`
+	Errors  []StudentImportError `json:"errors"`
+}
+
+type StudentImportError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type RolloverResponse struct {
+	Checksum       string                    `json:"checksum"`
+	Promoted       []RolloverStudentResponse `json:"promoted"`
+	Graduated      []RolloverStudentResponse `json:"graduated"`
+	ArchivedPoints int64                     `json:"archivedPoints"`
+}
+
+type RolloverStudentResponse struct {
+	StudentID     uuid.UUID `json:"studentId"`
 	StudentNumber string    `json:"studentNumber"`
 	Name          string    `json:"name"`
-	RoomNumber    string    `json:"roomNumber"`
-	Grade         int       `json:"grade"`
-	CreatedAt     time.Time `json:"createdAt"`
+	RoomNumber    string    `json:"roomNumber,omitempty"`
+	FromGrade     int       `json:"fromGrade"`
+	ToGrade       int       `json:"toGrade,omitempty"`
+}
+
+type BuildingResponse struct {
+	ID     uuid.UUID       `json:"id"`
+	Name   string          `json:"name"`
+	Floors []FloorResponse `json:"floors"`
+}
+
+type FloorResponse struct {
+	ID         uuid.UUID `json:"id"`
+	BuildingID uuid.UUID `json:"buildingId"`
+	Number     int       `json:"number"`
+}
+
+type RoomResponse struct {
+	ID           uuid.UUID     `json:"id"`
+	BuildingID   uuid.UUID     `json:"buildingId"`
+	BuildingName string        `json:"buildingName"`
+	FloorID      uuid.UUID     `json:"floorId"`
+	Floor        int           `json:"floor"`
+	Number       string        `json:"number"`
+	Capacity     int           `json:"capacity"`
+	Gender       string        `json:"gender,omitempty"`
+	Occupied     int           `json:"occupied"`
+	Vacancies    int           `json:"vacancies"`
+	Beds         []BedResponse `json:"beds"`
+}
+
+type BedResponse struct {
+	ID       uuid.UUID        `json:"id"`
+	Label    string           `json:"label"`
+	Occupant *StudentResponse `json:"occupant,omitempty"`
+}
+
+type FloorOccupancyResponse struct {
+	BuildingID   uuid.UUID `json:"buildingId"`
+	BuildingName string    `json:"buildingName"`
+	FloorID      uuid.UUID `json:"floorId"`
+	Floor        int       `json:"floor"`
+	Rooms        int       `json:"rooms"`
+	Capacity     int       `json:"capacity"`
+	Occupied     int       `json:"occupied"`
+	Vacancies    int       `json:"vacancies"`
+}
+
+type RoomAssignmentResponse struct {
+	ID            uuid.UUID        `json:"id"`
+	StudentID     *uuid.UUID       `json:"studentId,omitempty"`
+	StudentNumber string           `json:"studentNumber"`
+	StudentName   string           `json:"studentName"`
+	Student       *StudentResponse `json:"student,omitempty"`
+	RoomID        *uuid.UUID       `json:"roomId,omitempty"`
+	RoomNumber    string           `json:"roomNumber"`
+	BedID         *uuid.UUID       `json:"bedId,omitempty"`
+	BedLabel      string           `json:"bedLabel"`
+	StartedAt     time.Time        `json:"startedAt"`
+	EndedAt       *time.Time       `json:"endedAt,omitempty"`
+	Reason        string           `json:"reason,omitempty"`
+}
+
+type AllocationPlanResponse struct {
+	ID                    uuid.UUID                 `json:"id"`
+	Name                  string                    `json:"name"`
+	Status                string                    `json:"status"`
+	GroupByGrade          bool                      `json:"groupByGrade"`
+	KeepCurrentRoom       bool                      `json:"keepCurrentRoom"`
+	Summary               AllocationSummaryResponse `json:"summary"`
+	Items                 []AllocationItemResponse  `json:"items,omitempty"`
+	UnmetRoommateRequests []RoommateRequestResponse `json:"unmetRoommateRequests,omitempty"`
+	CreatedBy             *uuid.UUID                `json:"createdBy,omitempty"`
+	CommittedAt           *time.Time                `json:"committedAt,omitempty"`
+	CreatedAt             time.Time                 `json:"createdAt"`
+}
+
+type AllocationSummaryResponse struct {
+	Students   int `json:"students"`
+	Assigned   int `json:"assigned"`
+	Unassigned int `json:"unassigned"`
+	Moved      int `json:"moved"`
+}
+
+type AllocationItemResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	StudentID         uuid.UUID  `json:"studentId"`
+	StudentNumber     string     `json:"studentNumber"`
+	StudentName       string     `json:"studentName"`
+	Grade             int        `json:"grade"`
+	Gender            string     `json:"gender,omitempty"`
+	CurrentRoomNumber string     `json:"currentRoomNumber,omitempty"`
+	RoomID            *uuid.UUID `json:"roomId,omitempty"`
+	RoomNumber        string     `json:"roomNumber,omitempty"`
+	BedID             *uuid.UUID `json:"bedId,omitempty"`
+	BedLabel          string     `json:"bedLabel,omitempty"`
+	Fixed             bool       `json:"fixed"`
+	Note              string     `json:"note,omitempty"`
+}
+
+type RoommateRequestResponse struct {
+	StudentID  uuid.UUID `json:"studentId"`
+	RoommateID uuid.UUID `json:"roommateId"`
+}
+
+type StudentProfileResponse struct {
+	BirthDate         string                     `json:"birthDate,omitempty"`
+	Phone             string                     `json:"phone,omitempty"`
+	MedicalNotes      string                     `json:"medicalNotes,omitempty"`
+	EmergencyContacts []EmergencyContactResponse `json:"emergencyContacts"`
+}
+
+type EmergencyContactResponse struct {
+	Name         string `json:"name"`
+	Relationship string `json:"relationship"`
+	Phone        string `json:"phone"`
+}
+
+type GuardianResponse struct {
+	ID             uuid.UUID `json:"id"`
+	StudentID      uuid.UUID `json:"studentId"`
+	Name           string    `json:"name"`
+	Relationship   string    `json:"relationship"`
+	Phone          string    `json:"phone"`
+	Email          string    `json:"email,omitempty"`
+	IsPrimary      bool      `json:"isPrimary"`
+	SMSConsent     bool      `json:"smsConsent"`
+	EmailConsent   bool      `json:"emailConsent"`
+	MedicalConsent bool      `json:"medicalConsent"`
+	UpdatedAt      time.Time `json:"updatedAt"`
 }
 
 type PointReasonResponse struct {
-	ID    uuid.UUID `json:"id"`
-	Name  string    `json:"name"`
-	Type  string    `json:"type"`
-	Score int       `json:"score"`
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	Score          int       `json:"score"`
+	CouncilAllowed bool      `json:"councilAllowed"`
 }
 
 type PointResponse struct {
@@ -59,8 +319,25 @@ type PointResponse struct {
 	Reason      *PointReasonResponse `json:"reason,omitempty"`
 	GivenBy     *UserResponse        `json:"givenBy,omitempty"`
 	GivenAt     time.Time            `json:"givenAt"`
+	ProposedBy  *UserResponse        `json:"proposedBy,omitempty"`
+	ProposalID  *uuid.UUID           `json:"proposalId,omitempty"`
 	Cancelled   bool                 `json:"cancelled"`
 	CancelledAt *time.Time           `json:"cancelledAt,omitempty"`
+	ArchivedAt  *time.Time           `json:"archivedAt,omitempty"`
+}
+
+type PointProposalResponse struct {
+	ID            uuid.UUID            `json:"id"`
+	Student       *StudentResponse     `json:"student,omitempty"`
+	Reason        *PointReasonResponse `json:"reason,omitempty"`
+	ProposedBy    *UserResponse        `json:"proposedBy,omitempty"`
+	Note          string               `json:"note"`
+	Status        string               `json:"status"`
+	ReviewedBy    *UserResponse        `json:"reviewedBy,omitempty"`
+	ReviewedAt    *time.Time           `json:"reviewedAt,omitempty"`
+	ReviewComment string               `json:"reviewComment,omitempty"`
+	PointID       *uuid.UUID           `json:"pointId,omitempty"`
+	CreatedAt     time.Time            `json:"createdAt"`
 }
 
 type PointSummary struct {
@@ -75,6 +352,7 @@ type DutyResponse struct {
 	Type      string        `json:"type"`
 	Date      string        `json:"date"`
 	Floor     *int          `json:"floor,omitempty"`
+	FloorID   *uuid.UUID    `json:"floorId,omitempty"`
 	Assignee  *UserResponse `json:"assignee,omitempty"`
 	CreatedAt time.Time     `json:"createdAt"`
 }
@@ -89,12 +367,14 @@ type DutySwapRequestResponse struct {
 }
 
 type AuditLogResponse struct {
-	ID         uuid.UUID     `json:"id"`
-	User       *UserResponse `json:"user,omitempty"`
-	Action     string        `json:"action"`
-	EntityType string        `json:"entityType"`
-	EntityID   *uuid.UUID    `json:"entityId,omitempty"`
-	Details    interface{}   `json:"details,omitempty"`
-	IPAddress  string        `json:"ipAddress"`
-	CreatedAt  time.Time     `json:"createdAt"`
+	ID           uuid.UUID     `json:"id"`
+	User         *UserResponse `json:"user,omitempty"`
+	APIKeyID     *uuid.UUID    `json:"apiKeyId,omitempty"`
+	Impersonator *UserResponse `json:"impersonator,omitempty"`
+	Action       string        `json:"action"`
+	EntityType   string        `json:"entityType"`
+	EntityID     *uuid.UUID    `json:"entityId,omitempty"`
+	Details      interface{}   `json:"details,omitempty"`
+	IPAddress    string        `json:"ipAddress"`
+	CreatedAt    time.Time     `json:"createdAt"`
 }
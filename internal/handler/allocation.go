@@ -0,0 +1,355 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AllocationHandler struct {
+	allocationService *service.AllocationService
+	auditService      *service.AuditService
+}
+
+func NewAllocationHandler(allocationService *service.AllocationService, auditService *service.AuditService) *AllocationHandler {
+	return &AllocationHandler{allocationService: allocationService, auditService: auditService}
+}
+
+// Create godoc
+// @Summary 호실 배정안 생성
+// @Description 호실 현황과 조건(학년, 성별, 룸메이트 희망, 기존 호실 유지, 고정 배정)으로 배정안 미리보기 생성
+// @Tags 호실배정
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateAllocationPlanRequest true "배정 조건"
+// @Success 201 {object} dto.Response{data=dto.AllocationPlanResponse}
+// @Failure 400 {object} dto.Response
+// @Router /allocations [post]
+func (h *AllocationHandler) Create(c *gin.Context) {
+	var req dto.CreateAllocationPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+	plan, err := h.allocationService.Create(req, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "allocation_plan", &plan.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toAllocationPlanResponse(plan, true),
+	})
+}
+
+// GetAll godoc
+// @Summary 호실 배정안 목록
+// @Description 배정안 목록과 요약 조회
+// @Tags 호실배정
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (createdAt, name, 내림차순은 - 접두사)" default(-createdAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.AllocationPlanResponse}
+// @Failure 400 {object} dto.Response
+// @Router /allocations [get]
+func (h *AllocationHandler) GetAll(c *gin.Context) {
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	plans, meta, err := h.allocationService.GetAll(query)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.AllocationPlanResponse{}
+	for _, p := range plans {
+		responses = append(responses, toAllocationPlanResponse(&p, false))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// GetByID godoc
+// @Summary 호실 배정안 상세
+// @Description 학생별 배정 결과, 미배정 사유, 지켜지지 않은 룸메이트 희망 조회
+// @Tags 호실배정
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "배정안 ID"
+// @Success 200 {object} dto.Response{data=dto.AllocationPlanResponse}
+// @Failure 404 {object} dto.Response
+// @Router /allocations/{id} [get]
+func (h *AllocationHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid allocation plan id",
+		})
+		return
+	}
+
+	plan, err := h.allocationService.GetByID(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toAllocationPlanResponse(plan, true),
+	})
+}
+
+// UpdateItem godoc
+// @Summary 호실 배정안 수정
+// @Description 배정안에서 학생의 침대를 직접 지정하거나 배정 해제 (bedId를 비우면 해제)
+// @Tags 호실배정
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "배정안 ID"
+// @Param itemId path string true "배정 항목 ID"
+// @Param request body dto.UpdateAllocationItemRequest true "침대 정보"
+// @Success 200 {object} dto.Response{data=dto.AllocationPlanResponse}
+// @Failure 400 {object} dto.Response
+// @Router /allocations/{id}/items/{itemId} [put]
+func (h *AllocationHandler) UpdateItem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid allocation plan id",
+		})
+		return
+	}
+
+	itemID, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid allocation item id",
+		})
+		return
+	}
+
+	var req dto.UpdateAllocationItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	plan, err := h.allocationService.UpdateItem(id, itemID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "allocation_plan", &plan.ID, map[string]any{
+		"itemId": itemID,
+		"bedId":  req.BedID,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toAllocationPlanResponse(plan, true),
+	})
+}
+
+// Commit godoc
+// @Summary 호실 배정안 확정
+// @Description 배정안을 한 번에 적용 (하나라도 실패하면 전체 취소, 미배정 학생은 기존 침대 유지, 확정 시점에 성별과 침대 점유를 다시 확인)
+// @Tags 호실배정
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "배정안 ID"
+// @Success 200 {object} dto.Response{data=dto.AllocationPlanResponse}
+// @Failure 400 {object} dto.Response
+// @Router /allocations/{id}/commit [post]
+func (h *AllocationHandler) Commit(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid allocation plan id",
+		})
+		return
+	}
+
+	plan, err := h.allocationService.Commit(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionCommitAllocation, "allocation_plan", &plan.ID, map[string]int{
+		"students": len(plan.Items),
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toAllocationPlanResponse(plan, true),
+	})
+}
+
+// Delete godoc
+// @Summary 호실 배정안 삭제
+// @Description 확정되지 않은 배정안 삭제
+// @Tags 호실배정
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "배정안 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /allocations/{id} [delete]
+func (h *AllocationHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid allocation plan id",
+		})
+		return
+	}
+
+	if err := h.allocationService.Delete(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "allocation_plan", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+func toAllocationPlanResponse(p *model.AllocationPlan, withItems bool) dto.AllocationPlanResponse {
+	resp := dto.AllocationPlanResponse{
+		ID:              p.ID,
+		Name:            p.Name,
+		Status:          string(p.Status),
+		GroupByGrade:    p.GroupByGrade,
+		KeepCurrentRoom: p.KeepCurrentRoom,
+		CreatedBy:       p.CreatedBy,
+		CommittedAt:     p.CommittedAt,
+		CreatedAt:       p.CreatedAt,
+	}
+
+	rooms := map[uuid.UUID]*uuid.UUID{}
+	for _, item := range p.Items {
+		resp.Summary.Students++
+		if item.BedID == nil {
+			resp.Summary.Unassigned++
+		} else {
+			resp.Summary.Assigned++
+		}
+		if item.Student != nil && !model.SameBed(item.Student.BedID, item.BedID) {
+			resp.Summary.Moved++
+		}
+		if item.Bed != nil {
+			rooms[item.StudentID] = &item.Bed.RoomID
+		}
+
+		if withItems {
+			resp.Items = append(resp.Items, toAllocationItemResponse(&item))
+		}
+	}
+
+	if p.Summary != nil {
+		resp.Summary = dto.AllocationSummaryResponse{
+			Students:   p.Summary.Students,
+			Assigned:   p.Summary.Assigned,
+			Unassigned: p.Summary.Unassigned,
+			Moved:      p.Summary.Moved,
+		}
+	}
+
+	if withItems {
+		for _, r := range p.RoommateRequests {
+			a, b := rooms[r.StudentID], rooms[r.RoommateID]
+			if a == nil || b == nil || *a != *b {
+				resp.UnmetRoommateRequests = append(resp.UnmetRoommateRequests, dto.RoommateRequestResponse{
+					StudentID:  r.StudentID,
+					RoommateID: r.RoommateID,
+				})
+			}
+		}
+	}
+
+	return resp
+}
+
+func toAllocationItemResponse(i *model.AllocationItem) dto.AllocationItemResponse {
+	resp := dto.AllocationItemResponse{
+		ID:        i.ID,
+		StudentID: i.StudentID,
+		BedID:     i.BedID,
+		Fixed:     i.Fixed,
+		Note:      i.Note,
+	}
+	if i.Student != nil {
+		resp.StudentNumber = i.Student.StudentNumber
+		resp.StudentName = i.Student.Name
+		resp.Grade = i.Student.Grade
+		resp.Gender = string(i.Student.Gender)
+		resp.CurrentRoomNumber = i.Student.RoomNumber
+	}
+	if i.Bed != nil {
+		resp.BedLabel = i.Bed.Label
+		resp.RoomID = &i.Bed.RoomID
+		if i.Bed.Room != nil {
+			resp.RoomNumber = i.Bed.Room.Number
+		}
+	}
+	return resp
+}
@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type APIKeyHandler struct {
+	apiKeyService *service.APIKeyService
+	auditService  *service.AuditService
+}
+
+func NewAPIKeyHandler(apiKeyService *service.APIKeyService, auditService *service.AuditService) *APIKeyHandler {
+	return &APIKeyHandler{apiKeyService: apiKeyService, auditService: auditService}
+}
+
+// CreateServiceAccount godoc
+// @Summary 서비스 계정 생성
+// @Description 키오스크, 연동 스크립트 등에서 사용할 서비스 계정 생성 (비밀번호 로그인 불가)
+// @Tags 서비스 계정
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateServiceAccountRequest true "서비스 계정 정보"
+// @Success 201 {object} dto.Response{data=dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Router /service-accounts [post]
+func (h *APIKeyHandler) CreateServiceAccount(c *gin.Context) {
+	var req dto.CreateServiceAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	user, err := h.apiKeyService.CreateServiceAccount(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "user", &user.ID, map[string]any{
+		"name":           user.Name,
+		"serviceAccount": true,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toUserResponse(user),
+	})
+}
+
+// GetServiceAccounts godoc
+// @Summary 서비스 계정 목록
+// @Description 모든 서비스 계정 조회
+// @Tags 서비스 계정
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (name, email, role, createdAt, 내림차순은 - 접두사)" default(name)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Router /service-accounts [get]
+func (h *APIKeyHandler) GetServiceAccounts(c *gin.Context) {
+	var page dto.PageQuery
+	if err := c.ShouldBindQuery(&page); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	users, meta, err := h.apiKeyService.GetServiceAccounts(page)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.UserResponse{}
+	for _, u := range users {
+		responses = append(responses, toUserResponse(&u))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// CreateKey godoc
+// @Summary API 키 발급
+// @Description 서비스 계정의 API 키 발급 (키 원문은 발급 시에만 반환, 요청 시 Authorization: ApiKey {key} 헤더 사용)
+// @Tags 서비스 계정
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "서비스 계정 ID"
+// @Param request body dto.CreateAPIKeyRequest true "API 키 정보"
+// @Success 201 {object} dto.Response{data=dto.APIKeyResponse}
+// @Failure 400 {object} dto.Response
+// @Router /service-accounts/{id}/api-keys [post]
+func (h *APIKeyHandler) CreateKey(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid service account id",
+		})
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	actor := actorFrom(c)
+	key, rawKey, err := h.apiKeyService.CreateKey(id, req, actor.UserID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actor, model.AuditActionCreateAPIKey, "api_key", &key.ID, map[string]any{
+		"serviceAccountId": id,
+		"name":             key.Name,
+		"scopes":           key.Scopes,
+	}, c.ClientIP())
+
+	resp := toAPIKeyResponse(key)
+	resp.Key = rawKey
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// GetKeys godoc
+// @Summary API 키 목록
+// @Description 서비스 계정의 API 키 목록 조회
+// @Tags 서비스 계정
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "서비스 계정 ID"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (createdAt, name, 내림차순은 - 접두사)" default(-createdAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.APIKeyResponse}
+// @Failure 400 {object} dto.Response
+// @Router /service-accounts/{id}/api-keys [get]
+func (h *APIKeyHandler) GetKeys(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid service account id",
+		})
+		return
+	}
+
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	keys, meta, err := h.apiKeyService.GetKeys(id, query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.APIKeyResponse{}
+	for _, k := range keys {
+		responses = append(responses, toAPIKeyResponse(&k))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// Revoke godoc
+// @Summary API 키 폐기
+// @Description API 키 폐기 (즉시 사용 불가)
+// @Tags 서비스 계정
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "API 키 ID"
+// @Success 200 {object} dto.Response{data=dto.APIKeyResponse}
+// @Failure 400 {object} dto.Response
+// @Router /api-keys/{id} [delete]
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid api key id",
+		})
+		return
+	}
+
+	key, err := h.apiKeyService.Revoke(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionRevokeAPIKey, "api_key", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toAPIKeyResponse(key),
+	})
+}
+
+func toAPIKeyResponse(k *model.APIKey) dto.APIKeyResponse {
+	return dto.APIKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		LastUsedAt: k.LastUsedAt,
+		ExpiresAt:  k.ExpiresAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
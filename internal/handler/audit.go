@@ -2,13 +2,16 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
 	"dormi-api/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type AuditHandler struct {
@@ -26,12 +29,15 @@ func NewAuditHandler(auditService *service.AuditService) *AuditHandler {
 // @Produce json
 // @Security BearerAuth
 // @Param userId query string false "사용자 ID"
+// @Param impersonatorId query string false "대리 접속한 관리자 ID"
 // @Param action query string false "작업 유형"
 // @Param entityType query string false "엔티티 유형"
 // @Param startDate query string false "시작일 (RFC3339)"
 // @Param endDate query string false "종료일 (RFC3339)"
 // @Param page query int false "페이지" default(1)
-// @Param limit query int false "페이지당 개수" default(20)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (createdAt, action, 내림차순은 - 접두사)" default(-createdAt)
 // @Success 200 {object} dto.PaginatedResponse{data=[]dto.AuditLogResponse}
 // @Failure 400 {object} dto.Response
 // @Failure 403 {object} dto.Response
@@ -46,9 +52,9 @@ func (h *AuditHandler) GetAll(c *gin.Context) {
 		return
 	}
 
-	logs, total, err := h.auditService.GetAll(query)
+	logs, meta, err := h.auditService.GetAll(query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -60,26 +66,17 @@ func (h *AuditHandler) GetAll(c *gin.Context) {
 		responses = append(responses, toAuditLogResponse(&log))
 	}
 
-	totalPages := int(total) / query.Limit
-	if int(total)%query.Limit > 0 {
-		totalPages++
-	}
-
 	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
-		Meta: &dto.Pagination{
-			Page:       query.Page,
-			Limit:      query.Limit,
-			Total:      total,
-			TotalPages: totalPages,
-		},
+		Meta:    meta,
 	})
 }
 
 func toAuditLogResponse(log *model.AuditLog) dto.AuditLogResponse {
 	resp := dto.AuditLogResponse{
 		ID:         log.ID,
+		APIKeyID:   log.APIKeyID,
 		Action:     string(log.Action),
 		EntityType: log.EntityType,
 		EntityID:   log.EntityID,
@@ -88,12 +85,13 @@ func toAuditLogResponse(log *model.AuditLog) dto.AuditLogResponse {
 	}
 
 	if log.User != nil {
-		resp.User = &dto.UserResponse{
-			ID:    log.User.ID,
-			Email: log.User.Email,
-			Name:  log.User.Name,
-			Role:  string(log.User.Role),
-		}
+		user := toUserResponse(log.User)
+		resp.User = &user
+	}
+
+	if log.Impersonator != nil {
+		impersonator := toUserResponse(log.Impersonator)
+		resp.Impersonator = &impersonator
 	}
 
 	if log.Details != nil {
@@ -104,3 +102,24 @@ func toAuditLogResponse(log *model.AuditLog) dto.AuditLogResponse {
 
 	return resp
 }
+
+func actorFrom(c *gin.Context) service.Actor {
+	actor := service.Actor{UserID: c.MustGet("userID").(uuid.UUID)}
+	if apiKeyID, exists := c.Get("apiKeyID"); exists {
+		id := apiKeyID.(uuid.UUID)
+		actor.APIKeyID = &id
+	}
+	if impersonatorID, exists := c.Get("impersonatorID"); exists {
+		id := impersonatorID.(uuid.UUID)
+		actor.ImpersonatorID = &id
+	}
+	return actor
+}
+
+func listErrorStatus(err error) int {
+	var pageErr *pagination.Error
+	if errors.As(err, &pageErr) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
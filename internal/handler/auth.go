@@ -1,10 +1,15 @@
 package handler
 
 import (
+	"errors"
+	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/password"
 	"dormi-api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -13,16 +18,17 @@ import (
 
 type AuthHandler struct {
 	authService  *service.AuthService
+	mfaService   *service.MFAService
 	auditService *service.AuditService
 }
 
-func NewAuthHandler(authService *service.AuthService, auditService *service.AuditService) *AuthHandler {
-	return &AuthHandler{authService: authService, auditService: auditService}
+func NewAuthHandler(authService *service.AuthService, mfaService *service.MFAService, auditService *service.AuditService) *AuthHandler {
+	return &AuthHandler{authService: authService, mfaService: mfaService, auditService: auditService}
 }
 
 // Login godoc
 // @Summary 로그인
-// @Description 이메일과 비밀번호로 로그인하여 JWT 토큰 발급
+// @Description 이메일과 비밀번호로 로그인하여 액세스 토큰과 리프레시 토큰 발급 (2단계 인증 사용 시 mfaToken 발급)
 // @Tags 인증
 // @Accept json
 // @Produce json
@@ -30,6 +36,9 @@ func NewAuthHandler(authService *service.AuthService, auditService *service.Audi
 // @Success 200 {object} dto.Response{data=dto.LoginResponse}
 // @Failure 400 {object} dto.Response
 // @Failure 401 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Failure 429 {object} dto.Response
+// @Failure 500 {object} dto.Response
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req dto.LoginRequest
@@ -41,7 +50,112 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.authService.Login(req)
+	resp, err := h.authService.Login(req, clientInfo(c))
+	if err != nil {
+		var lockedErr *service.AccountLockedError
+		if errors.As(err, &lockedErr) {
+			h.auditService.Log(service.Actor{}, model.AuditActionLoginFailed, "user", nil, map[string]string{
+				"email":  req.Email,
+				"reason": "locked",
+			}, c.ClientIP())
+			if lockedErr.Triggered {
+				h.auditService.Log(service.Actor{}, model.AuditActionAccountLocked, "user", nil, map[string]any{
+					"email":       req.Email,
+					"lockedUntil": lockedErr.Until,
+				}, c.ClientIP())
+			}
+
+			retryAfter := int(time.Until(lockedErr.Until).Seconds()) + 1
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.JSON(http.StatusTooManyRequests, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		var throttledErr *service.IPThrottledError
+		if errors.As(err, &throttledErr) {
+			h.auditService.Log(service.Actor{}, model.AuditActionLoginFailed, "user", nil, map[string]string{
+				"email":  req.Email,
+				"reason": "ip_throttled",
+			}, c.ClientIP())
+
+			retryAfter := int(time.Until(throttledErr.Until).Seconds()) + 1
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.JSON(http.StatusTooManyRequests, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		if errors.Is(err, service.ErrAccountDeactivated) {
+			h.auditService.Log(service.Actor{}, model.AuditActionLoginFailed, "user", nil, map[string]string{
+				"email":  req.Email,
+				"reason": "deactivated",
+			}, c.ClientIP())
+
+			c.JSON(http.StatusForbidden, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			h.auditService.Log(service.Actor{}, model.AuditActionLoginFailed, "user", nil, map[string]string{
+				"email":  req.Email,
+				"reason": "invalid_credentials",
+			}, c.ClientIP())
+
+			c.JSON(http.StatusUnauthorized, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		log.Printf("Login for %s failed: %v", req.Email, err)
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   "failed to sign in",
+		})
+		return
+	}
+
+	if !resp.MFARequired {
+		h.auditService.Log(service.Actor{UserID: resp.User.ID}, model.AuditActionLogin, "user", &resp.User.ID, nil, c.ClientIP())
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toLoginResponse(resp),
+	})
+}
+
+// Refresh godoc
+// @Summary 토큰 갱신
+// @Description 리프레시 토큰으로 새 액세스 토큰과 리프레시 토큰 발급 (사용한 리프레시 토큰은 폐기됨)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body dto.RefreshTokenRequest true "리프레시 토큰"
+// @Success 200 {object} dto.Response{data=dto.LoginResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 401 {object} dto.Response
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.authService.Refresh(req.RefreshToken, clientInfo(c))
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, dto.Response{
 			Success: false,
@@ -50,11 +164,63 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(resp.User.ID, model.AuditActionLogin, "user", &resp.User.ID, nil, c.ClientIP())
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toLoginResponse(resp),
+	})
+}
+
+// Logout godoc
+// @Summary 로그아웃
+// @Description 현재 세션을 종료하고 발급된 토큰 폐기
+// @Tags 인증
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response
+// @Failure 500 {object} dto.Response
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	sessionID := c.MustGet("sessionID").(uuid.UUID)
+
+	if err := h.authService.Logout(sessionID); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionLogout, "session", &sessionID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+// LogoutAll godoc
+// @Summary 모든 기기에서 로그아웃
+// @Description 본인 계정의 모든 세션을 종료하고 발급된 토큰 폐기
+// @Tags 인증
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response
+// @Failure 500 {object} dto.Response
+// @Router /auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	if err := h.authService.LogoutAll(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionLogout, "user", &userID, map[string]bool{"allSessions": true}, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
-		Data:    resp,
 	})
 }
 
@@ -86,21 +252,16 @@ func (h *AuthHandler) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, dto.Response{
 			Success: false,
 			Error:   err.Error(),
+			Details: passwordViolations(err),
 		})
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionCreate, "user", &user.ID, map[string]string{"email": user.Email}, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "user", &user.ID, map[string]string{"email": user.Email}, c.ClientIP())
 
 	c.JSON(http.StatusCreated, dto.Response{
 		Success: true,
-		Data: dto.UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  string(user.Role),
-		},
+		Data:    toUserResponse(user),
 	})
 }
 
@@ -110,14 +271,30 @@ func (h *AuthHandler) CreateUser(c *gin.Context) {
 // @Tags 사용자
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} dto.Response{data=[]dto.UserResponse}
+// @Param status query string false "상태 (ACTIVE, INACTIVE)"
+// @Param role query string false "역할 (ADMIN, SUPERVISOR, COUNCIL)"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (name, email, role, createdAt, 내림차순은 - 접두사)" default(name)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.UserResponse}
+// @Failure 400 {object} dto.Response
 // @Failure 401 {object} dto.Response
 // @Failure 403 {object} dto.Response
 // @Router /users [get]
 func (h *AuthHandler) GetAllUsers(c *gin.Context) {
-	users, err := h.authService.GetAllUsers()
+	var query dto.UserQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	users, meta, err := h.authService.GetAllUsers(query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -126,17 +303,13 @@ func (h *AuthHandler) GetAllUsers(c *gin.Context) {
 
 	responses := []dto.UserResponse{}
 	for _, u := range users {
-		responses = append(responses, dto.UserResponse{
-			ID:    u.ID,
-			Email: u.Email,
-			Name:  u.Name,
-			Role:  string(u.Role),
-		})
+		responses = append(responses, toUserResponse(&u))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
@@ -172,18 +345,13 @@ func (h *AuthHandler) GetUserByID(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
-		Data: dto.UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  string(user.Role),
-		},
+		Data:    toUserResponse(user),
 	})
 }
 
 // UpdateUser godoc
 // @Summary 사용자 수정
-// @Description 사용자 정보 수정 (관리자 전용)
+// @Description 사용자 정보 수정 (관리자 전용, 역할이나 비밀번호 변경 시 해당 사용자의 토큰 폐기, 마지막 활성 관리자의 역할은 변경 불가)
 // @Tags 사용자
 // @Accept json
 // @Produce json
@@ -217,35 +385,69 @@ func (h *AuthHandler) UpdateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, dto.Response{
 			Success: false,
 			Error:   err.Error(),
+			Details: passwordViolations(err),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "user", &user.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toUserResponse(user),
+	})
+}
+
+// DeactivateUser godoc
+// @Summary 사용자 비활성화
+// @Description 사용자 계정 비활성화 (관리자 전용, 로그인 차단 및 모든 세션 폐기, 기록은 유지)
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Success 200 {object} dto.Response{data=dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Router /users/{id}/deactivate [post]
+func (h *AuthHandler) DeactivateUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid user id",
 		})
 		return
 	}
 
 	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionUpdate, "user", &user.ID, nil, c.ClientIP())
+
+	user, err := h.authService.DeactivateUser(id, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDeactivateUser, "user", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
-		Data: dto.UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  string(user.Role),
-		},
+		Data:    toUserResponse(user),
 	})
 }
 
-// DeleteUser godoc
-// @Summary 사용자 삭제
-// @Description 사용자 삭제 (관리자 전용)
+// ReactivateUser godoc
+// @Summary 사용자 재활성화
+// @Description 비활성화된 사용자 계정 재활성화 (관리자 전용)
 // @Tags 사용자
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "사용자 ID"
-// @Success 200 {object} dto.Response
+// @Success 200 {object} dto.Response{data=dto.UserResponse}
 // @Failure 400 {object} dto.Response
-// @Router /users/{id} [delete]
-func (h *AuthHandler) DeleteUser(c *gin.Context) {
+// @Router /users/{id}/reactivate [post]
+func (h *AuthHandler) ReactivateUser(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.Response{
@@ -255,7 +457,8 @@ func (h *AuthHandler) DeleteUser(c *gin.Context) {
 		return
 	}
 
-	if err := h.authService.DeleteUser(id); err != nil {
+	user, err := h.authService.ReactivateUser(id)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.Response{
 			Success: false,
 			Error:   err.Error(),
@@ -263,8 +466,93 @@ func (h *AuthHandler) DeleteUser(c *gin.Context) {
 		return
 	}
 
+	h.auditService.Log(actorFrom(c), model.AuditActionReactivateUser, "user", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toUserResponse(user),
+	})
+}
+
+// Impersonate godoc
+// @Summary 사용자 대리 접속
+// @Description 대상 사용자로 접속하는 임시 토큰 발급 (users:impersonate 권한 필요, 갱신 불가, 모든 작업은 접속한 사용자 기록과 함께 감사 로그에 남음)
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Success 200 {object} dto.Response{data=dto.LoginResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Router /users/{id}/impersonate [post]
+func (h *AuthHandler) Impersonate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid user id",
+		})
+		return
+	}
+
+	if _, exists := c.Get("apiKeyID"); exists {
+		c.JSON(http.StatusForbidden, dto.Response{
+			Success: false,
+			Error:   "api keys cannot impersonate users",
+		})
+		return
+	}
+
 	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionDelete, "user", &id, nil, c.ClientIP())
+
+	resp, err := h.authService.Impersonate(userID, id, clientInfo(c))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionImpersonateUser, "user", &id, map[string]interface{}{
+		"expiresAt": resp.ExpiresAt,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toLoginResponse(resp),
+	})
+}
+
+// UnlockUser godoc
+// @Summary 계정 잠금 해제
+// @Description 로그인 실패로 잠긴 계정의 잠금 해제 (관리자 전용)
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /users/{id}/unlock [post]
+func (h *AuthHandler) UnlockUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid user id",
+		})
+		return
+	}
+
+	if err := h.authService.UnlockUser(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUnlockAccount, "user", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -273,13 +561,13 @@ func (h *AuthHandler) DeleteUser(c *gin.Context) {
 
 // ChangePassword godoc
 // @Summary 비밀번호 변경
-// @Description 본인 계정 비밀번호 변경
+// @Description 본인 계정 비밀번호 변경 (다른 세션은 모두 종료되고 현재 세션에는 새 토큰 발급)
 // @Tags 인증
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param request body dto.ChangePasswordRequest true "비밀번호 변경 정보"
-// @Success 200 {object} dto.Response
+// @Success 200 {object} dto.Response{data=dto.LoginResponse}
 // @Failure 400 {object} dto.Response
 // @Router /auth/password [patch]
 func (h *AuthHandler) ChangePassword(c *gin.Context) {
@@ -293,16 +581,61 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	}
 
 	userID := c.MustGet("userID").(uuid.UUID)
+	sessionID := c.MustGet("sessionID").(uuid.UUID)
 
-	if err := h.authService.ChangePassword(userID, req); err != nil {
+	resp, err := h.authService.ChangePassword(userID, sessionID, req)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.Response{
 			Success: false,
 			Error:   err.Error(),
+			Details: passwordViolations(err),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
+		Data:    toLoginResponse(resp),
 	})
 }
+
+func toUserResponse(u *model.User) dto.UserResponse {
+	return dto.UserResponse{
+		ID:                 u.ID,
+		Email:              u.Email,
+		Name:               u.Name,
+		Role:               string(u.Role),
+		Status:             string(u.Status),
+		DeactivatedAt:      u.DeactivatedAt,
+		ServiceAccount:     u.ServiceAccount,
+		MustChangePassword: u.MustChangePassword,
+	}
+}
+
+func toLoginResponse(r *service.LoginResult) dto.LoginResponse {
+	resp := dto.LoginResponse{
+		Token:                  r.Token,
+		RefreshToken:           r.RefreshToken,
+		ExpiresAt:              r.ExpiresAt,
+		MFARequired:            r.MFARequired,
+		MFAToken:               r.MFAToken,
+		MFAEnrollmentRequired:  r.MFAEnrollmentRequired,
+		PasswordChangeRequired: r.PasswordChangeRequired,
+		User:                   toUserResponse(r.User),
+	}
+
+	if r.Impersonator != nil {
+		impersonator := toUserResponse(r.Impersonator)
+		resp.Impersonator = &impersonator
+	}
+
+	return resp
+}
+
+func passwordViolations(err error) interface{} {
+	var policyErr *password.PolicyError
+	if errors.As(err, &policyErr) {
+		return policyErr.Violations
+	}
+	return nil
+}
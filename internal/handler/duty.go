@@ -51,8 +51,7 @@ func (h *DutyHandler) Create(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionCreate, "duty", &duty.ID, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "duty", &duty.ID, nil, c.ClientIP())
 
 	c.JSON(http.StatusCreated, dto.Response{
 		Success: true,
@@ -106,7 +105,11 @@ func (h *DutyHandler) GetByID(c *gin.Context) {
 // @Param assigneeId query string false "담당자 ID"
 // @Param startDate query string false "시작일 (YYYY-MM-DD)"
 // @Param endDate query string false "종료일 (YYYY-MM-DD)"
-// @Success 200 {object} dto.Response{data=[]dto.DutyResponse}
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (date, type, createdAt, 내림차순은 - 접두사)" default(date)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.DutyResponse}
 // @Failure 400 {object} dto.Response
 // @Router /duties [get]
 func (h *DutyHandler) GetAll(c *gin.Context) {
@@ -119,9 +122,9 @@ func (h *DutyHandler) GetAll(c *gin.Context) {
 		return
 	}
 
-	duties, err := h.dutyService.GetAll(query)
+	duties, meta, err := h.dutyService.GetAll(query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -133,9 +136,10 @@ func (h *DutyHandler) GetAll(c *gin.Context) {
 		responses = append(responses, toDutyResponseWithRelations(&d))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
@@ -179,8 +183,7 @@ func (h *DutyHandler) Update(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionUpdate, "duty", &duty.ID, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "duty", &duty.ID, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -216,8 +219,7 @@ func (h *DutyHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionDelete, "duty", &id, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "duty", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -226,7 +228,7 @@ func (h *DutyHandler) Delete(c *gin.Context) {
 
 // Generate godoc
 // @Summary 당직 자동 생성
-// @Description 기간과 담당자 목록으로 당직 자동 생성
+// @Description 기간과 담당자 목록으로 당직 자동 생성 (비활성화된 담당자는 제외)
 // @Tags 당직
 // @Accept json
 // @Produce json
@@ -254,8 +256,7 @@ func (h *DutyHandler) Generate(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionCreate, "duty", nil, map[string]int{"count": len(duties)}, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "duty", nil, map[string]int{"count": len(duties)}, c.ClientIP())
 
 	responses := []dto.DutyResponse{}
 	for _, d := range duties {
@@ -309,7 +310,7 @@ func (h *DutyHandler) CreateSwapRequest(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(userID, model.AuditActionRequestDutySwap, "duty_swap_request", &swapReq.ID, map[string]interface{}{
+	h.auditService.Log(actorFrom(c), model.AuditActionRequestDutySwap, "duty_swap_request", &swapReq.ID, map[string]interface{}{
 		"sourceDutyId": sourceDutyID,
 		"targetDutyId": req.TargetDutyID,
 	}, c.ClientIP())
@@ -326,13 +327,27 @@ func (h *DutyHandler) CreateSwapRequest(c *gin.Context) {
 // @Tags 당직 교대
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} dto.Response{data=[]dto.DutySwapRequestResponse}
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (createdAt, 내림차순은 - 접두사)" default(-createdAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.DutySwapRequestResponse}
+// @Failure 400 {object} dto.Response
 // @Router /duty-swap-requests/pending [get]
 func (h *DutyHandler) GetPendingSwapRequests(c *gin.Context) {
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
 	userID := c.MustGet("userID").(uuid.UUID)
-	requests, err := h.swapService.GetPendingForUser(userID)
+	requests, meta, err := h.swapService.GetPendingPageForUser(userID, query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -344,9 +359,10 @@ func (h *DutyHandler) GetPendingSwapRequests(c *gin.Context) {
 		responses = append(responses, toSwapRequestResponse(&r))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
@@ -356,13 +372,27 @@ func (h *DutyHandler) GetPendingSwapRequests(c *gin.Context) {
 // @Tags 당직 교대
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} dto.Response{data=[]dto.DutySwapRequestResponse}
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (createdAt, 내림차순은 - 접두사)" default(-createdAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.DutySwapRequestResponse}
+// @Failure 400 {object} dto.Response
 // @Router /duty-swap-requests/my [get]
 func (h *DutyHandler) GetMySwapRequests(c *gin.Context) {
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
 	userID := c.MustGet("userID").(uuid.UUID)
-	requests, err := h.swapService.GetMyRequests(userID)
+	requests, meta, err := h.swapService.GetMyRequests(userID, query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -374,9 +404,10 @@ func (h *DutyHandler) GetMySwapRequests(c *gin.Context) {
 		responses = append(responses, toSwapRequestResponse(&r))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
@@ -409,7 +440,7 @@ func (h *DutyHandler) ApproveSwapRequest(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(userID, model.AuditActionApproveDutySwap, "duty_swap_request", &id, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionApproveDutySwap, "duty_swap_request", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -445,7 +476,7 @@ func (h *DutyHandler) RejectSwapRequest(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(userID, model.AuditActionRejectDutySwap, "duty_swap_request", &id, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionRejectDutySwap, "duty_swap_request", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -458,6 +489,7 @@ func toDutyResponse(d *model.Duty) dto.DutyResponse {
 		Type:      string(d.Type),
 		Date:      d.Date.Format("2006-01-02"),
 		Floor:     d.Floor,
+		FloorID:   d.FloorID,
 		CreatedAt: d.CreatedAt,
 	}
 }
@@ -466,12 +498,8 @@ func toDutyResponseWithRelations(d *model.Duty) dto.DutyResponse {
 	resp := toDutyResponse(d)
 
 	if d.Assignee != nil {
-		resp.Assignee = &dto.UserResponse{
-			ID:    d.Assignee.ID,
-			Email: d.Assignee.Email,
-			Name:  d.Assignee.Name,
-			Role:  string(d.Assignee.Role),
-		}
+		assignee := toUserResponse(d.Assignee)
+		resp.Assignee = &assignee
 	}
 
 	return resp
@@ -485,12 +513,8 @@ func toSwapRequestResponse(r *model.DutySwapRequest) dto.DutySwapRequestResponse
 	}
 
 	if r.Requester != nil {
-		resp.Requester = &dto.UserResponse{
-			ID:    r.Requester.ID,
-			Email: r.Requester.Email,
-			Name:  r.Requester.Name,
-			Role:  string(r.Requester.Role),
-		}
+		requester := toUserResponse(r.Requester)
+		resp.Requester = &requester
 	}
 
 	if r.SourceDuty != nil {
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type EmailChangeHandler struct {
+	emailChangeService *service.EmailChangeService
+	auditService       *service.AuditService
+}
+
+func NewEmailChangeHandler(emailChangeService *service.EmailChangeService, auditService *service.AuditService) *EmailChangeHandler {
+	return &EmailChangeHandler{emailChangeService: emailChangeService, auditService: auditService}
+}
+
+// Request godoc
+// @Summary 이메일 변경 요청
+// @Description 현재 비밀번호 확인 후 새 이메일로 확인 링크 발송 (확인 전까지 이메일은 바뀌지 않음, 이메일은 소문자로 저장됨)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.EmailChangeRequest true "새 이메일과 현재 비밀번호"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Failure 503 {object} dto.Response
+// @Router /auth/me/email [post]
+func (h *EmailChangeHandler) Request(c *gin.Context) {
+	var req dto.EmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	newEmail, err := h.emailChangeService.Request(userID, req)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, service.ErrMailDisabled) {
+			status = http.StatusServiceUnavailable
+		}
+		c.JSON(status, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionRequestEmailChange, "user", &userID, map[string]string{"newEmail": newEmail}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+// Confirm godoc
+// @Summary 이메일 변경 확인
+// @Description 새 이메일로 받은 토큰으로 이메일 변경 완료 (토큰은 1회용, 대기 중인 비밀번호 재설정 링크는 무효화됨)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body dto.EmailChangeConfirmRequest true "확인 토큰"
+// @Success 200 {object} dto.Response{data=dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/email-change/confirm [post]
+func (h *EmailChangeHandler) Confirm(c *gin.Context) {
+	var req dto.EmailChangeConfirmRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	user, oldEmail, err := h.emailChangeService.Confirm(req.Token)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(service.Actor{UserID: user.ID}, model.AuditActionChangeEmail, "user", &user.ID, map[string]string{
+		"oldEmail": oldEmail,
+		"newEmail": user.Email,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toUserResponse(user),
+	})
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type GuardianHandler struct {
+	guardianService *service.GuardianService
+	auditService    *service.AuditService
+}
+
+func NewGuardianHandler(guardianService *service.GuardianService, auditService *service.AuditService) *GuardianHandler {
+	return &GuardianHandler{guardianService: guardianService, auditService: auditService}
+}
+
+// GetAll godoc
+// @Summary 보호자 목록
+// @Description 학생의 보호자 목록 조회 (민감 정보 권한 필요)
+// @Tags 보호자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "학생 ID"
+// @Success 200 {object} dto.Response{data=[]dto.GuardianResponse}
+// @Failure 404 {object} dto.Response
+// @Router /students/{id}/guardians [get]
+func (h *GuardianHandler) GetAll(c *gin.Context) {
+	studentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	guardians, err := h.guardianService.GetByStudentID(studentID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.GuardianResponse{}
+	for _, g := range guardians {
+		responses = append(responses, toGuardianResponse(&g))
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    responses,
+	})
+}
+
+// Create godoc
+// @Summary 보호자 등록
+// @Description 학생의 보호자 등록 (학생당 최대 5명, 주 보호자는 한 명)
+// @Tags 보호자
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "학생 ID"
+// @Param request body dto.GuardianRequest true "보호자 정보"
+// @Success 201 {object} dto.Response{data=dto.GuardianResponse}
+// @Failure 400 {object} dto.Response
+// @Router /students/{id}/guardians [post]
+func (h *GuardianHandler) Create(c *gin.Context) {
+	studentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	var req dto.GuardianRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	guardian, err := h.guardianService.Create(studentID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "guardian", &guardian.ID, map[string]interface{}{
+		"studentId": studentID,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toGuardianResponse(guardian),
+	})
+}
+
+// Update godoc
+// @Summary 보호자 수정
+// @Description 학생의 보호자 정보 수정
+// @Tags 보호자
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "학생 ID"
+// @Param guardianId path string true "보호자 ID"
+// @Param request body dto.GuardianRequest true "보호자 정보"
+// @Success 200 {object} dto.Response{data=dto.GuardianResponse}
+// @Failure 400 {object} dto.Response
+// @Router /students/{id}/guardians/{guardianId} [put]
+func (h *GuardianHandler) Update(c *gin.Context) {
+	studentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("guardianId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid guardian id",
+		})
+		return
+	}
+
+	var req dto.GuardianRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	guardian, err := h.guardianService.Update(studentID, id, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "guardian", &guardian.ID, map[string]interface{}{
+		"studentId": studentID,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toGuardianResponse(guardian),
+	})
+}
+
+// Delete godoc
+// @Summary 보호자 삭제
+// @Description 학생의 보호자 삭제
+// @Tags 보호자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "학생 ID"
+// @Param guardianId path string true "보호자 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /students/{id}/guardians/{guardianId} [delete]
+func (h *GuardianHandler) Delete(c *gin.Context) {
+	studentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("guardianId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid guardian id",
+		})
+		return
+	}
+
+	if err := h.guardianService.Delete(studentID, id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "guardian", &id, map[string]interface{}{
+		"studentId": studentID,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+func toGuardianResponse(g *model.Guardian) dto.GuardianResponse {
+	return dto.GuardianResponse{
+		ID:             g.ID,
+		StudentID:      g.StudentID,
+		Name:           g.Name,
+		Relationship:   g.Relationship,
+		Phone:          g.Phone,
+		Email:          g.Email,
+		IsPrimary:      g.IsPrimary,
+		SMSConsent:     g.SMSConsent,
+		EmailConsent:   g.EmailConsent,
+		MedicalConsent: g.MedicalConsent,
+		UpdatedAt:      g.UpdatedAt,
+	}
+}
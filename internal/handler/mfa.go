@@ -0,0 +1,302 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// LoginMFA godoc
+// @Summary 2단계 인증 로그인
+// @Description 로그인 시 발급된 mfaToken과 OTP 코드 또는 복구 코드로 로그인 완료
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body dto.MFALoginRequest true "2단계 인증 정보"
+// @Success 200 {object} dto.Response{data=dto.LoginResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 401 {object} dto.Response
+// @Failure 429 {object} dto.Response
+// @Router /auth/login/mfa [post]
+func (h *AuthHandler) LoginMFA(c *gin.Context) {
+	var req dto.MFALoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.authService.VerifyMFA(req, clientInfo(c))
+	if err != nil {
+		var lockedErr *service.AccountLockedError
+		if errors.As(err, &lockedErr) {
+			if lockedErr.Triggered {
+				h.auditService.Log(service.Actor{}, model.AuditActionAccountLocked, "user", nil, map[string]any{
+					"lockedUntil": lockedErr.Until,
+					"reason":      "mfa",
+				}, c.ClientIP())
+			}
+
+			retryAfter := int(time.Until(lockedErr.Until).Seconds()) + 1
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.JSON(http.StatusTooManyRequests, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		var throttledErr *service.IPThrottledError
+		if errors.As(err, &throttledErr) {
+			h.auditService.Log(service.Actor{}, model.AuditActionLoginFailed, "user", nil, map[string]string{
+				"reason": "ip_throttled",
+			}, c.ClientIP())
+
+			retryAfter := int(time.Until(throttledErr.Until).Seconds()) + 1
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.JSON(http.StatusTooManyRequests, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		h.auditService.Log(service.Actor{}, model.AuditActionLoginFailed, "user", nil, map[string]string{
+			"reason": "invalid_mfa_code",
+		}, c.ClientIP())
+
+		c.JSON(http.StatusUnauthorized, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	method := "totp"
+	if req.RecoveryCode != "" {
+		method = "recovery_code"
+	}
+	h.auditService.Log(service.Actor{UserID: resp.User.ID}, model.AuditActionLogin, "user", &resp.User.ID, map[string]string{"mfa": method}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toLoginResponse(resp),
+	})
+}
+
+// GetMFAStatus godoc
+// @Summary 2단계 인증 상태 조회
+// @Description 본인 계정의 2단계 인증 활성화 여부와 남은 복구 코드 수 조회
+// @Tags 인증
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=dto.MFAStatusResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/2fa [get]
+func (h *AuthHandler) GetMFAStatus(c *gin.Context) {
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	status, err := h.mfaService.GetStatus(userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    status,
+	})
+}
+
+// SetupMFA godoc
+// @Summary 2단계 인증 등록 시작
+// @Description TOTP 비밀키와 인증 앱 등록용 otpauth URI 발급
+// @Tags 인증
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=dto.MFASetupResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/2fa/setup [post]
+func (h *AuthHandler) SetupMFA(c *gin.Context) {
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	resp, err := h.mfaService.Setup(userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// EnableMFA godoc
+// @Summary 2단계 인증 활성화
+// @Description 인증 앱의 OTP 코드를 확인하여 2단계 인증을 활성화하고 복구 코드 발급
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "OTP 코드"
+// @Success 200 {object} dto.Response{data=dto.MFARecoveryCodesResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/2fa/enable [post]
+func (h *AuthHandler) EnableMFA(c *gin.Context) {
+	var req dto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	codes, err := h.mfaService.Enable(userID, req.Code)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionEnableMFA, "user", &userID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    dto.MFARecoveryCodesResponse{RecoveryCodes: codes},
+	})
+}
+
+// DisableMFA godoc
+// @Summary 2단계 인증 해제
+// @Description OTP 코드를 확인하여 2단계 인증 해제 (필수 역할은 해제 불가)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "OTP 코드"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /auth/2fa/disable [post]
+func (h *AuthHandler) DisableMFA(c *gin.Context) {
+	var req dto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	if err := h.mfaService.Disable(userID, req.Code); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDisableMFA, "user", &userID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary 복구 코드 재발급
+// @Description OTP 코드를 확인하여 복구 코드 재발급 (기존 복구 코드는 폐기됨)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "OTP 코드"
+// @Success 200 {object} dto.Response{data=dto.MFARecoveryCodesResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/2fa/recovery-codes [post]
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req dto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	codes, err := h.mfaService.RegenerateRecoveryCodes(userID, req.Code)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionRegenerateMFACodes, "user", &userID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    dto.MFARecoveryCodesResponse{RecoveryCodes: codes},
+	})
+}
+
+// ResetUserMFA godoc
+// @Summary 사용자 2단계 인증 초기화
+// @Description 인증 기기를 분실한 사용자의 2단계 인증 초기화 (관리자 전용)
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /users/{id}/2fa/reset [post]
+func (h *AuthHandler) ResetUserMFA(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid user id",
+		})
+		return
+	}
+
+	if err := h.mfaService.Reset(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDisableMFA, "user", &id, map[string]bool{"reset": true}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	oidcBindingCookie     = "dormi_oidc_binding"
+	oidcBindingCookiePath = "/api/auth/oidc"
+)
+
+type OIDCHandler struct {
+	oidcService  *service.OIDCService
+	auditService *service.AuditService
+	cfg          *config.Config
+}
+
+func NewOIDCHandler(oidcService *service.OIDCService, auditService *service.AuditService, cfg *config.Config) *OIDCHandler {
+	return &OIDCHandler{oidcService: oidcService, auditService: auditService, cfg: cfg}
+}
+
+// GetProviders godoc
+// @Summary SSO 제공자 목록
+// @Description 사용 가능한 OpenID Connect 로그인 제공자 목록 조회
+// @Tags 인증
+// @Produce json
+// @Success 200 {object} dto.Response{data=[]string}
+// @Router /auth/oidc/providers [get]
+func (h *OIDCHandler) GetProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    h.oidcService.Providers(),
+	})
+}
+
+// Authorize godoc
+// @Summary SSO 로그인 시작
+// @Description OpenID Connect 인가 URL 발급 (authorization code + PKCE, 반환된 URL로 이동 후 콜백에서 code와 state 전달, 요청한 브라우저에 HttpOnly 쿠키를 설정하며 콜백도 같은 브라우저에서 쿠키와 함께 호출해야 함)
+// @Tags 인증
+// @Produce json
+// @Param provider path string true "제공자 이름"
+// @Success 200 {object} dto.Response{data=dto.OIDCAuthorizeResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/oidc/{provider}/authorize [get]
+func (h *OIDCHandler) Authorize(c *gin.Context) {
+	resp, binding, err := h.oidcService.AuthorizationURL(c.Param("provider"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcBindingCookie, binding, int(h.cfg.OIDCAuthRequestTTL.Seconds()), oidcBindingCookiePath, "", h.cfg.OIDCCookieSecure, true)
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Callback godoc
+// @Summary SSO 로그인 완료
+// @Description 제공자에서 받은 code와 state로 로그인하여 토큰 발급 (인가 URL을 발급받은 브라우저의 쿠키 필요, 검증된 이메일과 일치하는 기존 사용자만 로그인 가능, 2단계 인증 사용 시 mfaToken 발급)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param provider path string true "제공자 이름"
+// @Param request body dto.OIDCCallbackRequest true "인가 코드와 state"
+// @Success 200 {object} dto.Response{data=dto.LoginResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 401 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Router /auth/oidc/{provider}/callback [post]
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	var req dto.OIDCCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	provider := c.Param("provider")
+	binding, _ := c.Cookie(oidcBindingCookie)
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcBindingCookie, "", -1, oidcBindingCookiePath, "", h.cfg.OIDCCookieSecure, true)
+
+	resp, err := h.oidcService.Callback(provider, req, binding, clientInfo(c))
+	if err != nil {
+		h.auditService.Log(service.Actor{}, model.AuditActionLoginFailed, "user", nil, map[string]string{
+			"provider": provider,
+			"reason":   err.Error(),
+		}, c.ClientIP())
+
+		status := http.StatusUnauthorized
+		if errors.Is(err, service.ErrAccountDeactivated) {
+			status = http.StatusForbidden
+		}
+		c.JSON(status, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	if !resp.MFARequired {
+		h.auditService.Log(service.Actor{UserID: resp.User.ID}, model.AuditActionLogin, "user", &resp.User.ID, map[string]string{
+			"method":   "oidc",
+			"provider": provider,
+		}, c.ClientIP())
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toLoginResponse(resp),
+	})
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordResetHandler struct {
+	passwordResetService *service.PasswordResetService
+	auditService         *service.AuditService
+}
+
+func NewPasswordResetHandler(passwordResetService *service.PasswordResetService, auditService *service.AuditService) *PasswordResetHandler {
+	return &PasswordResetHandler{passwordResetService: passwordResetService, auditService: auditService}
+}
+
+// Request godoc
+// @Summary 비밀번호 재설정 요청
+// @Description 등록된 이메일로 비밀번호 재설정 링크 발송 (계정 존재 여부와 관계없이 동일하게 응답)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body dto.PasswordResetRequest true "이메일"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Failure 503 {object} dto.Response
+// @Router /auth/password-reset/request [post]
+func (h *PasswordResetHandler) Request(c *gin.Context) {
+	var req dto.PasswordResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	if err := h.passwordResetService.Request(req.Email); err != nil {
+		if errors.Is(err, service.ErrMailDisabled) {
+			c.JSON(http.StatusServiceUnavailable, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   "failed to process password reset request",
+		})
+		return
+	}
+
+	h.auditService.Log(service.Actor{}, model.AuditActionRequestPasswordReset, "user", nil, map[string]string{"email": req.Email}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+// Confirm godoc
+// @Summary 비밀번호 재설정
+// @Description 메일로 받은 토큰으로 새 비밀번호 설정 (토큰은 1회용, 기존 세션은 모두 종료됨)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body dto.PasswordResetConfirmRequest true "재설정 정보"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /auth/password-reset/confirm [post]
+func (h *PasswordResetHandler) Confirm(c *gin.Context) {
+	var req dto.PasswordResetConfirmRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	user, err := h.passwordResetService.Confirm(req.Token, req.NewPassword)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+			Details: passwordViolations(err),
+		})
+		return
+	}
+
+	h.auditService.Log(service.Actor{UserID: user.ID}, model.AuditActionResetPassword, "user", &user.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PermissionHandler struct {
+	permissionService *service.PermissionService
+	auditService      *service.AuditService
+}
+
+func NewPermissionHandler(permissionService *service.PermissionService, auditService *service.AuditService) *PermissionHandler {
+	return &PermissionHandler{permissionService: permissionService, auditService: auditService}
+}
+
+// GetAll godoc
+// @Summary 권한 목록
+// @Description 시스템에서 사용하는 모든 권한 목록 조회 (관리자 전용)
+// @Tags 권한
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=[]string}
+// @Failure 403 {object} dto.Response
+// @Router /permissions [get]
+func (h *PermissionHandler) GetAll(c *gin.Context) {
+	permissions := []string{}
+	for _, p := range model.AllPermissions {
+		permissions = append(permissions, string(p))
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    permissions,
+	})
+}
+
+// GetRolePermissions godoc
+// @Summary 역할별 권한 조회
+// @Description 역할별로 부여된 권한 목록 조회 (관리자 전용)
+// @Tags 권한
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=[]dto.RolePermissionsResponse}
+// @Failure 403 {object} dto.Response
+// @Failure 500 {object} dto.Response
+// @Router /roles/permissions [get]
+func (h *PermissionHandler) GetRolePermissions(c *gin.Context) {
+	grants, err := h.permissionService.GetRolePermissions()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.RolePermissionsResponse{}
+	for _, role := range model.AllRoles {
+		responses = append(responses, toRolePermissionsResponse(role, grants[role]))
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    responses,
+	})
+}
+
+// UpdateRolePermissions godoc
+// @Summary 역할 권한 수정
+// @Description 역할에 부여된 권한 목록을 교체 (관리자 전용)
+// @Tags 권한
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role path string true "역할 (ADMIN, SUPERVISOR, COUNCIL)"
+// @Param request body dto.UpdateRolePermissionsRequest true "권한 목록"
+// @Success 200 {object} dto.Response{data=dto.RolePermissionsResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 403 {object} dto.Response
+// @Router /roles/{role}/permissions [put]
+func (h *PermissionHandler) UpdateRolePermissions(c *gin.Context) {
+	var req dto.UpdateRolePermissionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	role := model.Role(c.Param("role"))
+
+	permissions, err := h.permissionService.UpdateRolePermissions(role, req.Permissions)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdatePermissions, "role", nil, map[string]any{
+		"role":        role,
+		"permissions": permissions,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toRolePermissionsResponse(role, permissions),
+	})
+}
+
+func toRolePermissionsResponse(role model.Role, permissions []model.Permission) dto.RolePermissionsResponse {
+	resp := dto.RolePermissionsResponse{
+		Role:        string(role),
+		Permissions: []string{},
+	}
+	for _, p := range permissions {
+		resp.Permissions = append(resp.Permissions, string(p))
+	}
+	return resp
+}
@@ -52,7 +52,7 @@ func (h *PointHandler) GivePoint(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(userID, model.AuditActionGivePoint, "point", &point.ID, map[string]any{
+	h.auditService.Log(actorFrom(c), model.AuditActionGivePoint, "point", &point.ID, map[string]any{
 		"studentId": req.StudentID,
 		"reasonId":  req.ReasonID,
 	}, c.ClientIP())
@@ -95,7 +95,7 @@ func (h *PointHandler) BulkGivePoints(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(userID, model.AuditActionGivePoint, "point", nil, map[string]any{
+	h.auditService.Log(actorFrom(c), model.AuditActionGivePoint, "point", nil, map[string]any{
 		"studentIds": req.StudentIDs,
 		"reasonId":   req.ReasonID,
 		"count":      len(points),
@@ -122,7 +122,12 @@ func (h *PointHandler) BulkGivePoints(c *gin.Context) {
 // @Param type query string false "유형 (REWARD, PENALTY)"
 // @Param startDate query string false "시작일 (YYYY-MM-DD)"
 // @Param endDate query string false "종료일 (YYYY-MM-DD)"
-// @Success 200 {object} dto.Response{data=[]dto.PointResponse}
+// @Param includeArchived query bool false "졸업생의 보관된 상벌점 포함"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (givenAt, 내림차순은 - 접두사)" default(-givenAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.PointResponse}
 // @Failure 400 {object} dto.Response
 // @Router /points [get]
 func (h *PointHandler) GetAll(c *gin.Context) {
@@ -135,9 +140,9 @@ func (h *PointHandler) GetAll(c *gin.Context) {
 		return
 	}
 
-	points, err := h.pointService.GetAll(query)
+	points, meta, err := h.pointService.GetAll(query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -149,9 +154,10 @@ func (h *PointHandler) GetAll(c *gin.Context) {
 		responses = append(responses, toPointResponse(&p))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
@@ -162,7 +168,11 @@ func (h *PointHandler) GetAll(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param studentId path string true "학생 ID"
-// @Success 200 {object} dto.Response{data=[]dto.PointResponse}
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (givenAt, 내림차순은 - 접두사)" default(-givenAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.PointResponse}
 // @Failure 400 {object} dto.Response
 // @Router /points/student/{studentId} [get]
 func (h *PointHandler) GetByStudentID(c *gin.Context) {
@@ -175,9 +185,18 @@ func (h *PointHandler) GetByStudentID(c *gin.Context) {
 		return
 	}
 
-	points, err := h.pointService.GetByStudentID(studentID)
+	var page dto.PageQuery
+	if err := c.ShouldBindQuery(&page); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	points, meta, err := h.pointService.GetByStudentID(studentID, page)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -189,9 +208,10 @@ func (h *PointHandler) GetByStudentID(c *gin.Context) {
 		responses = append(responses, toPointResponse(&p))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
@@ -260,7 +280,7 @@ func (h *PointHandler) Cancel(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(userID, model.AuditActionCancelPoint, "point", &id, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionCancelPoint, "point", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -269,7 +289,7 @@ func (h *PointHandler) Cancel(c *gin.Context) {
 
 // Reset godoc
 // @Summary 상벌점 전체 초기화
-// @Description 모든 상벌점 삭제 (관리자 전용)
+// @Description 보관되지 않은 모든 상벌점 삭제 (졸업생의 보관된 상벌점은 유지, 관리자 전용)
 // @Tags 상벌점
 // @Produce json
 // @Security BearerAuth
@@ -277,7 +297,6 @@ func (h *PointHandler) Cancel(c *gin.Context) {
 // @Failure 500 {object} dto.Response
 // @Router /points/reset [delete]
 func (h *PointHandler) Reset(c *gin.Context) {
-	userID := c.MustGet("userID").(uuid.UUID)
 
 	if err := h.pointService.ResetAll(); err != nil {
 		c.JSON(http.StatusInternalServerError, dto.Response{
@@ -287,7 +306,7 @@ func (h *PointHandler) Reset(c *gin.Context) {
 		return
 	}
 
-	h.auditService.Log(userID, model.AuditActionResetPoints, "point", nil, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionResetPoints, "point", nil, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -298,8 +317,10 @@ func toPointResponse(p *model.Point) dto.PointResponse {
 	resp := dto.PointResponse{
 		ID:          p.ID,
 		GivenAt:     p.GivenAt,
+		ProposalID:  p.ProposalID,
 		Cancelled:   p.Cancelled,
 		CancelledAt: p.CancelledAt,
+		ArchivedAt:  p.ArchivedAt,
 	}
 
 	if p.Student != nil {
@@ -308,21 +329,18 @@ func toPointResponse(p *model.Point) dto.PointResponse {
 	}
 
 	if p.Reason != nil {
-		resp.Reason = &dto.PointReasonResponse{
-			ID:    p.Reason.ID,
-			Name:  p.Reason.Name,
-			Type:  string(p.Reason.Type),
-			Score: p.Reason.Score,
-		}
+		reason := toPointReasonResponse(p.Reason)
+		resp.Reason = &reason
 	}
 
 	if p.GivenByUser != nil {
-		resp.GivenBy = &dto.UserResponse{
-			ID:    p.GivenByUser.ID,
-			Email: p.GivenByUser.Email,
-			Name:  p.GivenByUser.Name,
-			Role:  string(p.GivenByUser.Role),
-		}
+		givenBy := toUserResponse(p.GivenByUser)
+		resp.GivenBy = &givenBy
+	}
+
+	if p.ProposedByUser != nil {
+		proposedBy := toUserResponse(p.ProposedByUser)
+		resp.ProposedBy = &proposedBy
 	}
 
 	return resp
@@ -0,0 +1,287 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PointProposalHandler struct {
+	proposalService *service.PointProposalService
+	auditService    *service.AuditService
+}
+
+func NewPointProposalHandler(proposalService *service.PointProposalService, auditService *service.AuditService) *PointProposalHandler {
+	return &PointProposalHandler{proposalService: proposalService, auditService: auditService}
+}
+
+// Create godoc
+// @Summary 상벌점 제안
+// @Description 학생회가 상벌점 부여를 제안 (학생회 허용 사유만, 일일 제한 있음)
+// @Tags 상벌점 제안
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreatePointProposalRequest true "제안 정보"
+// @Success 201 {object} dto.Response{data=dto.PointProposalResponse}
+// @Failure 400 {object} dto.Response
+// @Router /point-proposals [post]
+func (h *PointProposalHandler) Create(c *gin.Context) {
+	var req dto.CreatePointProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	proposal, err := h.proposalService.Create(req, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionProposePoint, "point_proposal", &proposal.ID, map[string]any{
+		"studentId": req.StudentID,
+		"reasonId":  req.ReasonID,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toPointProposalResponse(proposal),
+	})
+}
+
+// GetAll godoc
+// @Summary 상벌점 제안 목록
+// @Description 상벌점 제안 목록 조회 (필터링 지원)
+// @Tags 상벌점 제안
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "상태 (PENDING, APPROVED, REJECTED)"
+// @Param studentId query string false "학생 ID"
+// @Param proposedBy query string false "제안자 ID"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (createdAt, status, 내림차순은 - 접두사)" default(-createdAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.PointProposalResponse}
+// @Failure 400 {object} dto.Response
+// @Router /point-proposals [get]
+func (h *PointProposalHandler) GetAll(c *gin.Context) {
+	var query dto.PointProposalQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	proposals, meta, err := h.proposalService.GetAll(query)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.PointProposalResponse{}
+	for _, p := range proposals {
+		responses = append(responses, toPointProposalResponse(&p))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// GetMine godoc
+// @Summary 내 상벌점 제안 목록
+// @Description 내가 제안한 상벌점 목록 조회
+// @Tags 상벌점 제안
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (createdAt, status, 내림차순은 - 접두사)" default(-createdAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.PointProposalResponse}
+// @Failure 400 {object} dto.Response
+// @Router /point-proposals/my [get]
+func (h *PointProposalHandler) GetMine(c *gin.Context) {
+	var page dto.PageQuery
+	if err := c.ShouldBindQuery(&page); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+	proposals, meta, err := h.proposalService.GetMine(userID, page)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.PointProposalResponse{}
+	for _, p := range proposals {
+		responses = append(responses, toPointProposalResponse(&p))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// Approve godoc
+// @Summary 상벌점 제안 승인
+// @Description 대기 중인 제안을 승인하고 상벌점 부여. 승인 시점에 사유의 학생회 제안 허용 여부와 제안자의 일일 한도를 다시 확인하며, 부여된 상벌점에는 제안자와 제안 ID가 함께 기록됨
+// @Tags 상벌점 제안
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "제안 ID"
+// @Success 200 {object} dto.Response{data=dto.PointProposalResponse}
+// @Failure 400 {object} dto.Response
+// @Router /point-proposals/{id}/approve [patch]
+func (h *PointProposalHandler) Approve(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid proposal id",
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+	proposal, err := h.proposalService.Approve(id, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionApprovePointProposal, "point_proposal", &id, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionGivePoint, "point", proposal.PointID, map[string]any{
+		"studentId":  proposal.StudentID,
+		"reasonId":   proposal.ReasonID,
+		"proposalId": proposal.ID,
+		"proposedBy": proposal.ProposedBy,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toPointProposalResponse(proposal),
+	})
+}
+
+// Reject godoc
+// @Summary 상벌점 제안 거절
+// @Description 대기 중인 제안 거절
+// @Tags 상벌점 제안
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "제안 ID"
+// @Param request body dto.RejectPointProposalRequest false "거절 사유"
+// @Success 200 {object} dto.Response{data=dto.PointProposalResponse}
+// @Failure 400 {object} dto.Response
+// @Router /point-proposals/{id}/reject [patch]
+func (h *PointProposalHandler) Reject(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid proposal id",
+		})
+		return
+	}
+
+	var req dto.RejectPointProposalRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.Response{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+	proposal, err := h.proposalService.Reject(id, userID, req.Comment)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionRejectPointProposal, "point_proposal", &id, map[string]any{
+		"comment": req.Comment,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toPointProposalResponse(proposal),
+	})
+}
+
+func toPointProposalResponse(p *model.PointProposal) dto.PointProposalResponse {
+	resp := dto.PointProposalResponse{
+		ID:            p.ID,
+		Note:          p.Note,
+		Status:        string(p.Status),
+		ReviewedAt:    p.ReviewedAt,
+		ReviewComment: p.ReviewComment,
+		PointID:       p.PointID,
+		CreatedAt:     p.CreatedAt,
+	}
+
+	if p.Student != nil {
+		student := toStudentResponse(p.Student)
+		resp.Student = &student
+	}
+
+	if p.Reason != nil {
+		reason := toPointReasonResponse(p.Reason)
+		resp.Reason = &reason
+	}
+
+	if p.ProposedByUser != nil {
+		proposedBy := toUserResponse(p.ProposedByUser)
+		resp.ProposedBy = &proposedBy
+	}
+
+	if p.ReviewedByUser != nil {
+		reviewedBy := toUserResponse(p.ReviewedByUser)
+		resp.ReviewedBy = &reviewedBy
+	}
+
+	return resp
+}
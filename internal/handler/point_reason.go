@@ -50,8 +50,7 @@ func (h *PointReasonHandler) Create(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionCreate, "point_reason", &reason.ID, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "point_reason", &reason.ID, nil, c.ClientIP())
 
 	c.JSON(http.StatusCreated, dto.Response{
 		Success: true,
@@ -101,22 +100,26 @@ func (h *PointReasonHandler) GetByID(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param type query string false "유형 (REWARD, PENALTY)"
-// @Success 200 {object} dto.Response{data=[]dto.PointReasonResponse}
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (name, type, score, createdAt, 내림차순은 - 접두사)" default(name)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.PointReasonResponse}
+// @Failure 400 {object} dto.Response
 // @Router /point-reasons [get]
 func (h *PointReasonHandler) GetAll(c *gin.Context) {
-	pointType := c.Query("type")
-
-	var reasons []model.PointReason
-	var err error
-
-	if pointType != "" {
-		reasons, err = h.reasonService.GetByType(pointType)
-	} else {
-		reasons, err = h.reasonService.GetAll()
+	var query dto.PointReasonQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
 	}
 
+	reasons, meta, err := h.reasonService.GetAll(query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -128,9 +131,10 @@ func (h *PointReasonHandler) GetAll(c *gin.Context) {
 		responses = append(responses, toPointReasonResponse(&r))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
@@ -174,8 +178,7 @@ func (h *PointReasonHandler) Update(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionUpdate, "point_reason", &reason.ID, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "point_reason", &reason.ID, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -211,8 +214,7 @@ func (h *PointReasonHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionDelete, "point_reason", &id, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "point_reason", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
@@ -221,9 +223,10 @@ func (h *PointReasonHandler) Delete(c *gin.Context) {
 
 func toPointReasonResponse(r *model.PointReason) dto.PointReasonResponse {
 	return dto.PointReasonResponse{
-		ID:    r.ID,
-		Name:  r.Name,
-		Type:  string(r.Type),
-		Score: r.Score,
+		ID:             r.ID,
+		Name:           r.Name,
+		Type:           string(r.Type),
+		Score:          r.Score,
+		CouncilAllowed: r.CouncilAllowed,
 	}
 }
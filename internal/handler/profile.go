@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const upcomingDutyLimit = 10
+
+type ProfileHandler struct {
+	authService       *service.AuthService
+	permissionService *service.PermissionService
+	dutyService       *service.DutyService
+	swapService       *service.DutySwapRequestService
+	auditService      *service.AuditService
+}
+
+func NewProfileHandler(authService *service.AuthService, permissionService *service.PermissionService, dutyService *service.DutyService, swapService *service.DutySwapRequestService, auditService *service.AuditService) *ProfileHandler {
+	return &ProfileHandler{authService: authService, permissionService: permissionService, dutyService: dutyService, swapService: swapService, auditService: auditService}
+}
+
+// GetMe godoc
+// @Summary 내 정보 조회
+// @Description 로그인한 사용자 정보와 권한, 다가오는 당직, 대기 중인 교대 신청 조회
+// @Tags 인증
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=dto.MeResponse}
+// @Failure 404 {object} dto.Response
+// @Failure 500 {object} dto.Response
+// @Router /auth/me [get]
+func (h *ProfileHandler) GetMe(c *gin.Context) {
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	user, err := h.authService.GetUserByID(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.Response{
+			Success: false,
+			Error:   "user not found",
+		})
+		return
+	}
+
+	duties, err := h.dutyService.GetUpcomingForUser(userID, upcomingDutyLimit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	pending, err := h.swapService.GetPendingForUser(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	myPending, err := h.swapService.GetMyPendingRequests(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	resp := dto.MeResponse{
+		User:                  toUserResponse(user),
+		Permissions:           []string{},
+		UpcomingDuties:        []dto.DutyResponse{},
+		PendingSwapRequests:   []dto.DutySwapRequestResponse{},
+		MyPendingSwapRequests: []dto.DutySwapRequestResponse{},
+	}
+	if impersonatorID, exists := c.Get("impersonatorID"); exists {
+		if impersonator, err := h.authService.GetUserByID(impersonatorID.(uuid.UUID)); err == nil {
+			impersonatorResponse := toUserResponse(impersonator)
+			resp.Impersonator = &impersonatorResponse
+		}
+	}
+	for _, p := range h.permissionService.GetPermissionsForRole(user.Role) {
+		resp.Permissions = append(resp.Permissions, string(p))
+	}
+	for _, d := range duties {
+		resp.UpcomingDuties = append(resp.UpcomingDuties, toDutyResponse(&d))
+	}
+	for _, r := range pending {
+		resp.PendingSwapRequests = append(resp.PendingSwapRequests, toSwapRequestResponse(&r))
+	}
+	for _, r := range myPending {
+		resp.MyPendingSwapRequests = append(resp.MyPendingSwapRequests, toSwapRequestResponse(&r))
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// UpdateMe godoc
+// @Summary 내 정보 수정
+// @Description 로그인한 사용자의 이름 수정 (이메일은 POST /auth/me/email로 확인 절차를 거쳐 변경)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.UpdateProfileRequest true "수정할 정보"
+// @Success 200 {object} dto.Response{data=dto.UserResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/me [patch]
+func (h *ProfileHandler) UpdateMe(c *gin.Context) {
+	var req dto.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := c.MustGet("userID").(uuid.UUID)
+
+	user, err := h.authService.UpdateProfile(userID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "user", &userID, map[string]string{
+		"name": req.Name,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toUserResponse(user),
+	})
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RolloverHandler struct {
+	rolloverService *service.RolloverService
+	auditService    *service.AuditService
+}
+
+func NewRolloverHandler(rolloverService *service.RolloverService, auditService *service.AuditService) *RolloverHandler {
+	return &RolloverHandler{rolloverService: rolloverService, auditService: auditService}
+}
+
+// Preview godoc
+// @Summary 학년도 전환 미리보기
+// @Description 진급(1→2, 2→3), 졸업(3학년) 대상 학생과 보관될 상벌점 수 조회. 전환 시 응답의 checksum이 필요
+// @Tags 학년도
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=dto.RolloverResponse}
+// @Router /rollover/preview [get]
+func (h *RolloverHandler) Preview(c *gin.Context) {
+	preview, err := h.rolloverService.Preview()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    preview,
+	})
+}
+
+// Commit godoc
+// @Summary 학년도 전환
+// @Description 미리보기 내용대로 진급과 졸업을 한 번에 처리. 졸업생은 졸업생(ALUMNI) 상태로 삭제 처리되고 침대 배정이 해제되며 상벌점은 보관됨. 미리보기 이후 학생 정보가 바뀌었으면 실패
+// @Tags 학년도
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.RolloverRequest true "미리보기 checksum"
+// @Success 200 {object} dto.Response{data=dto.RolloverResponse}
+// @Failure 400 {object} dto.Response
+// @Router /rollover [post]
+func (h *RolloverHandler) Commit(c *gin.Context) {
+	var req dto.RolloverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.rolloverService.Commit(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionAcademicYearRollover, "student", nil, result, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    result,
+	})
+}
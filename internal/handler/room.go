@@ -0,0 +1,612 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RoomHandler struct {
+	roomService  *service.RoomService
+	auditService *service.AuditService
+}
+
+func NewRoomHandler(roomService *service.RoomService, auditService *service.AuditService) *RoomHandler {
+	return &RoomHandler{roomService: roomService, auditService: auditService}
+}
+
+// GetBuildings godoc
+// @Summary 건물 목록
+// @Description 건물과 층 목록 조회
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (name, createdAt, 내림차순은 - 접두사)" default(name)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.BuildingResponse}
+// @Failure 400 {object} dto.Response
+// @Router /buildings [get]
+func (h *RoomHandler) GetBuildings(c *gin.Context) {
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	buildings, meta, err := h.roomService.GetBuildings(query)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.BuildingResponse{}
+	for _, b := range buildings {
+		responses = append(responses, toBuildingResponse(&b))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// CreateBuilding godoc
+// @Summary 건물 등록
+// @Description 새로운 건물 등록
+// @Tags 호실
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.BuildingRequest true "건물 정보"
+// @Success 201 {object} dto.Response{data=dto.BuildingResponse}
+// @Failure 400 {object} dto.Response
+// @Router /buildings [post]
+func (h *RoomHandler) CreateBuilding(c *gin.Context) {
+	var req dto.BuildingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	building, err := h.roomService.CreateBuilding(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "building", &building.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toBuildingResponse(building),
+	})
+}
+
+// UpdateBuilding godoc
+// @Summary 건물 수정
+// @Description 건물 이름 수정
+// @Tags 호실
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "건물 ID"
+// @Param request body dto.BuildingRequest true "건물 정보"
+// @Success 200 {object} dto.Response{data=dto.BuildingResponse}
+// @Failure 400 {object} dto.Response
+// @Router /buildings/{id} [put]
+func (h *RoomHandler) UpdateBuilding(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid building id",
+		})
+		return
+	}
+
+	var req dto.BuildingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	building, err := h.roomService.UpdateBuilding(id, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "building", &building.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toBuildingResponse(building),
+	})
+}
+
+// DeleteBuilding godoc
+// @Summary 건물 삭제
+// @Description 층이 없는 건물 삭제
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "건물 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /buildings/{id} [delete]
+func (h *RoomHandler) DeleteBuilding(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid building id",
+		})
+		return
+	}
+
+	if err := h.roomService.DeleteBuilding(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "building", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+// CreateFloor godoc
+// @Summary 층 등록
+// @Description 건물에 층 등록
+// @Tags 호실
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "건물 ID"
+// @Param request body dto.CreateFloorRequest true "층 정보"
+// @Success 201 {object} dto.Response{data=dto.FloorResponse}
+// @Failure 400 {object} dto.Response
+// @Router /buildings/{id}/floors [post]
+func (h *RoomHandler) CreateFloor(c *gin.Context) {
+	buildingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid building id",
+		})
+		return
+	}
+
+	var req dto.CreateFloorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	floor, err := h.roomService.CreateFloor(buildingID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "floor", &floor.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toFloorResponse(floor),
+	})
+}
+
+// DeleteFloor godoc
+// @Summary 층 삭제
+// @Description 호실이 없는 층 삭제
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "층 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /floors/{id} [delete]
+func (h *RoomHandler) DeleteFloor(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid floor id",
+		})
+		return
+	}
+
+	if err := h.roomService.DeleteFloor(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "floor", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+// GetRooms godoc
+// @Summary 호실 목록
+// @Description 호실 목록과 침대별 배정 현황 조회
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Param buildingId query string false "건물 ID"
+// @Param floor query int false "층"
+// @Param gender query string false "성별 (MALE, FEMALE)"
+// @Param vacantOnly query bool false "빈 자리가 있는 호실만"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (number, capacity, createdAt, 내림차순은 - 접두사)" default(number)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.RoomResponse}
+// @Failure 400 {object} dto.Response
+// @Router /rooms [get]
+func (h *RoomHandler) GetRooms(c *gin.Context) {
+	var query dto.RoomListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	rooms, meta, err := h.roomService.GetRooms(query)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.RoomResponse{}
+	for _, r := range rooms {
+		responses = append(responses, toRoomResponse(&r))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// GetRoom godoc
+// @Summary 호실 상세
+// @Description 호실과 침대별 배정 현황 조회
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "호실 ID"
+// @Success 200 {object} dto.Response{data=dto.RoomResponse}
+// @Failure 404 {object} dto.Response
+// @Router /rooms/{id} [get]
+func (h *RoomHandler) GetRoom(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid room id",
+		})
+		return
+	}
+
+	room, err := h.roomService.GetRoom(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toRoomResponse(room),
+	})
+}
+
+// GetOccupancy godoc
+// @Summary 층별 수용 현황
+// @Description 건물, 층별 정원, 배정 인원, 빈 자리 수 조회
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=[]dto.FloorOccupancyResponse}
+// @Router /rooms/occupancy [get]
+func (h *RoomHandler) GetOccupancy(c *gin.Context) {
+	occupancy, err := h.roomService.GetOccupancy()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    occupancy,
+	})
+}
+
+// GetResidents godoc
+// @Summary 날짜별 호실 거주자
+// @Description 특정 날짜에 호실에 배정되어 있던 학생 조회 (삭제된 학생 포함)
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Param buildingId query string false "건물 ID (같은 호실 번호가 여러 건물에 있을 때)"
+// @Param room query string true "호실 번호"
+// @Param date query string true "날짜 (YYYY-MM-DD)"
+// @Success 200 {object} dto.Response{data=[]dto.RoomAssignmentResponse}
+// @Failure 400 {object} dto.Response
+// @Router /rooms/residents [get]
+func (h *RoomHandler) GetResidents(c *gin.Context) {
+	var query dto.RoomResidentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	assignments, err := h.roomService.GetResidents(query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.RoomAssignmentResponse{}
+	for _, a := range assignments {
+		responses = append(responses, toRoomAssignmentResponse(&a))
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    responses,
+	})
+}
+
+// CreateRoom godoc
+// @Summary 호실 등록
+// @Description 층에 호실 등록 (정원만큼 침대 생성)
+// @Tags 호실
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateRoomRequest true "호실 정보"
+// @Success 201 {object} dto.Response{data=dto.RoomResponse}
+// @Failure 400 {object} dto.Response
+// @Router /rooms [post]
+func (h *RoomHandler) CreateRoom(c *gin.Context) {
+	var req dto.CreateRoomRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	room, err := h.roomService.CreateRoom(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "room", &room.Room.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toRoomResponse(room),
+	})
+}
+
+// UpdateRoom godoc
+// @Summary 호실 수정
+// @Description 호실 번호, 정원, 성별 지정 수정 (정원을 줄이면 빈 침대부터 제거)
+// @Tags 호실
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "호실 ID"
+// @Param request body dto.UpdateRoomRequest true "수정할 정보"
+// @Success 200 {object} dto.Response{data=dto.RoomResponse}
+// @Failure 400 {object} dto.Response
+// @Router /rooms/{id} [put]
+func (h *RoomHandler) UpdateRoom(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid room id",
+		})
+		return
+	}
+
+	var req dto.UpdateRoomRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	room, err := h.roomService.UpdateRoom(id, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "room", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    toRoomResponse(room),
+	})
+}
+
+// DeleteRoom godoc
+// @Summary 호실 삭제
+// @Description 배정된 학생이 없는 호실 삭제
+// @Tags 호실
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "호실 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /rooms/{id} [delete]
+func (h *RoomHandler) DeleteRoom(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid room id",
+		})
+		return
+	}
+
+	if err := h.roomService.DeleteRoom(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "room", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+func toBuildingResponse(b *model.Building) dto.BuildingResponse {
+	resp := dto.BuildingResponse{
+		ID:     b.ID,
+		Name:   b.Name,
+		Floors: []dto.FloorResponse{},
+	}
+	for _, f := range b.Floors {
+		resp.Floors = append(resp.Floors, toFloorResponse(&f))
+	}
+	return resp
+}
+
+func toFloorResponse(f *model.Floor) dto.FloorResponse {
+	return dto.FloorResponse{
+		ID:         f.ID,
+		BuildingID: f.BuildingID,
+		Number:     f.Number,
+	}
+}
+
+func toRoomResponse(r *service.RoomOccupancy) dto.RoomResponse {
+	resp := dto.RoomResponse{
+		ID:        r.Room.ID,
+		FloorID:   r.Room.FloorID,
+		Number:    r.Room.Number,
+		Capacity:  r.Room.Capacity,
+		Gender:    string(r.Room.Gender),
+		Occupied:  len(r.Occupants),
+		Vacancies: r.Vacancies(),
+		Beds:      []dto.BedResponse{},
+	}
+
+	if r.Room.Floor != nil {
+		resp.Floor = r.Room.Floor.Number
+		resp.BuildingID = r.Room.Floor.BuildingID
+		if r.Room.Floor.Building != nil {
+			resp.BuildingName = r.Room.Floor.Building.Name
+		}
+	}
+
+	for _, bed := range r.Room.Beds {
+		bedResp := dto.BedResponse{ID: bed.ID, Label: bed.Label}
+		if student, ok := r.Occupants[bed.ID]; ok {
+			occupant := toStudentResponse(&student)
+			bedResp.Occupant = &occupant
+		}
+		resp.Beds = append(resp.Beds, bedResp)
+	}
+
+	return resp
+}
+
+func toRoomAssignmentResponse(a *model.RoomAssignment) dto.RoomAssignmentResponse {
+	resp := dto.RoomAssignmentResponse{
+		ID:            a.ID,
+		StudentID:     a.StudentID,
+		StudentNumber: a.StudentNumber,
+		StudentName:   a.StudentName,
+		RoomID:        a.RoomID,
+		RoomNumber:    a.RoomNumber,
+		BedID:         a.BedID,
+		BedLabel:      a.BedLabel,
+		StartedAt:     a.StartedAt,
+		EndedAt:       a.EndedAt,
+		Reason:        a.Reason,
+	}
+	if a.Student != nil {
+		student := toStudentResponse(a.Student)
+		resp.Student = &student
+	}
+	return resp
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetMySessions godoc
+// @Summary 내 로그인 세션 목록
+// @Description 본인 계정의 활성 세션 목록 조회 (기기, IP, 마지막 사용 시각)
+// @Tags 인증
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (lastSeenAt, createdAt, 내림차순은 - 접두사)" default(-lastSeenAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.SessionResponse}
+// @Failure 400 {object} dto.Response
+// @Router /auth/sessions [get]
+func (h *AuthHandler) GetMySessions(c *gin.Context) {
+	h.respondSessions(c, c.MustGet("userID").(uuid.UUID))
+}
+
+// TerminateMySession godoc
+// @Summary 내 세션 종료
+// @Description 본인 계정의 특정 세션을 원격으로 로그아웃
+// @Tags 인증
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "세션 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /auth/sessions/{id} [delete]
+func (h *AuthHandler) TerminateMySession(c *gin.Context) {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid session id",
+		})
+		return
+	}
+
+	h.terminateSession(c, c.MustGet("userID").(uuid.UUID), sessionID)
+}
+
+// GetUserSessions godoc
+// @Summary 사용자 세션 목록
+// @Description 특정 사용자의 활성 세션 목록 조회 (관리자 전용)
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (lastSeenAt, createdAt, 내림차순은 - 접두사)" default(-lastSeenAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.SessionResponse}
+// @Failure 400 {object} dto.Response
+// @Router /users/{id}/sessions [get]
+func (h *AuthHandler) GetUserSessions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid user id",
+		})
+		return
+	}
+
+	h.respondSessions(c, id)
+}
+
+// TerminateUserSession godoc
+// @Summary 사용자 세션 종료
+// @Description 특정 사용자의 세션을 원격으로 로그아웃 (관리자 전용)
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Param sessionId path string true "세션 ID"
+// @Success 200 {object} dto.Response
+// @Failure 400 {object} dto.Response
+// @Router /users/{id}/sessions/{sessionId} [delete]
+func (h *AuthHandler) TerminateUserSession(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid user id",
+		})
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("sessionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid session id",
+		})
+		return
+	}
+
+	h.terminateSession(c, id, sessionID)
+}
+
+func (h *AuthHandler) respondSessions(c *gin.Context, userID uuid.UUID) {
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	sessions, meta, err := h.authService.GetActiveSessions(userID, query)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	currentSessionID, _ := c.Get("sessionID")
+
+	responses := []dto.SessionResponse{}
+	for _, s := range sessions {
+		resp := toSessionResponse(&s)
+		resp.Current = s.ID == currentSessionID
+		responses = append(responses, resp)
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+func (h *AuthHandler) terminateSession(c *gin.Context, userID, sessionID uuid.UUID) {
+	if err := h.authService.TerminateSession(userID, sessionID); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionTerminateSession, "session", &sessionID, map[string]any{
+		"userId": userID,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+func toSessionResponse(s *model.Session) dto.SessionResponse {
+	return dto.SessionResponse{
+		ID:             s.ID,
+		AuthMethod:     s.AuthMethod,
+		ImpersonatorID: s.ImpersonatorID,
+		UserAgent:      s.UserAgent,
+		IPAddress:      s.IPAddress,
+		CreatedAt:      s.CreatedAt,
+		LastSeenAt:     s.LastSeenAt,
+		ExpiresAt:      s.ExpiresAt,
+	}
+}
+
+func clientInfo(c *gin.Context) service.ClientInfo {
+	return service.ClientInfo{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
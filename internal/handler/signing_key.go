@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"net/http"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SigningKeyHandler struct {
+	signingKeyService *service.SigningKeyService
+	auditService      *service.AuditService
+}
+
+func NewSigningKeyHandler(signingKeyService *service.SigningKeyService, auditService *service.AuditService) *SigningKeyHandler {
+	return &SigningKeyHandler{signingKeyService: signingKeyService, auditService: auditService}
+}
+
+func (h *SigningKeyHandler) GetJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.signingKeyService.JWKS())
+}
+
+// GetAll godoc
+// @Summary 서명 키 목록
+// @Description 토큰 서명 및 검증에 사용 중인 키 목록 조회 (비밀 키는 포함하지 않음)
+// @Tags 서명 키
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.Response{data=[]dto.SigningKeyResponse}
+// @Router /signing-keys [get]
+func (h *SigningKeyHandler) GetAll(c *gin.Context) {
+	keys, err := h.signingKeyService.GetAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.SigningKeyResponse{}
+	for _, k := range keys {
+		responses = append(responses, toSigningKeyResponse(&k))
+	}
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    responses,
+	})
+}
+
+// Rotate godoc
+// @Summary 서명 키 교체
+// @Description 새 서명 키를 생성하여 사용 (기존 키는 발급된 토큰이 만료될 때까지 검증용으로 JWKS에 유지)
+// @Tags 서명 키
+// @Produce json
+// @Security BearerAuth
+// @Success 201 {object} dto.Response{data=dto.SigningKeyResponse}
+// @Failure 500 {object} dto.Response
+// @Router /signing-keys/rotate [post]
+func (h *SigningKeyHandler) Rotate(c *gin.Context) {
+	key, err := h.signingKeyService.Rotate()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionRotateSigningKey, "signing_key", nil, map[string]string{
+		"kid":       key.ID,
+		"algorithm": key.Algorithm,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusCreated, dto.Response{
+		Success: true,
+		Data:    toSigningKeyResponse(key),
+	})
+}
+
+func toSigningKeyResponse(k *model.SigningKey) dto.SigningKeyResponse {
+	return dto.SigningKeyResponse{
+		ID:        k.ID,
+		Algorithm: k.Algorithm,
+		Active:    k.Active,
+		RetiresAt: k.RetiresAt,
+		CreatedAt: k.CreatedAt,
+	}
+}
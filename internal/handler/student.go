@@ -1,9 +1,15 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"dormi-api/internal/dto"
+	"dormi-api/internal/middleware"
 	"dormi-api/internal/model"
 	"dormi-api/internal/service"
 
@@ -12,17 +18,18 @@ import (
 )
 
 type StudentHandler struct {
-	studentService *service.StudentService
-	auditService   *service.AuditService
+	studentService    *service.StudentService
+	permissionService *service.PermissionService
+	auditService      *service.AuditService
 }
 
-func NewStudentHandler(studentService *service.StudentService, auditService *service.AuditService) *StudentHandler {
-	return &StudentHandler{studentService: studentService, auditService: auditService}
+func NewStudentHandler(studentService *service.StudentService, permissionService *service.PermissionService, auditService *service.AuditService) *StudentHandler {
+	return &StudentHandler{studentService: studentService, permissionService: permissionService, auditService: auditService}
 }
 
 // Create godoc
 // @Summary 학생 생성
-// @Description 새로운 학생 등록
+// @Description 새로운 학생 등록 (생년월일, 연락처, 건강 메모, 비상 연락처 입력은 민감 정보 권한 필요)
 // @Tags 학생
 // @Accept json
 // @Produce json
@@ -42,6 +49,15 @@ func (h *StudentHandler) Create(c *gin.Context) {
 		return
 	}
 
+	sensitive := req.BirthDate != "" || req.Phone != "" || req.MedicalNotes != "" || len(req.EmergencyContacts) > 0
+	if sensitive && !h.canViewSensitive(c) {
+		c.JSON(http.StatusForbidden, dto.Response{
+			Success: false,
+			Error:   "insufficient permissions",
+		})
+		return
+	}
+
 	student, err := h.studentService.Create(req)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.Response{
@@ -51,18 +67,17 @@ func (h *StudentHandler) Create(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionCreate, "student", &student.ID, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionCreate, "student", &student.ID, nil, c.ClientIP())
 
 	c.JSON(http.StatusCreated, dto.Response{
 		Success: true,
-		Data:    toStudentResponse(student),
+		Data:    h.studentResponse(c, student),
 	})
 }
 
 // GetByID godoc
 // @Summary 학생 상세 조회
-// @Description ID로 학생 정보 조회
+// @Description ID로 학생 정보 조회 (민감 정보 권한이 있으면 profile 포함)
 // @Tags 학생
 // @Produce json
 // @Security BearerAuth
@@ -92,7 +107,7 @@ func (h *StudentHandler) GetByID(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
-		Data:    toStudentResponse(student),
+		Data:    h.studentResponse(c, student),
 	})
 }
 
@@ -104,12 +119,17 @@ func (h *StudentHandler) GetByID(c *gin.Context) {
 // @Security BearerAuth
 // @Param search query string false "검색어 (이름, 학번)"
 // @Param grade query int false "학년"
+// @Param buildingId query string false "건물 ID"
 // @Param room query string false "방 번호"
-// @Success 200 {object} dto.Response{data=[]dto.StudentResponse}
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (studentNumber, name, grade, roomNumber, createdAt, 내림차순은 - 접두사)" default(studentNumber)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.StudentResponse}
 // @Failure 400 {object} dto.Response
 // @Router /students [get]
 func (h *StudentHandler) GetAll(c *gin.Context) {
-	var query dto.StudentQuery
+	var query dto.StudentListQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
 		c.JSON(http.StatusBadRequest, dto.Response{
 			Success: false,
@@ -118,9 +138,9 @@ func (h *StudentHandler) GetAll(c *gin.Context) {
 		return
 	}
 
-	students, err := h.studentService.GetAll(query)
+	students, meta, err := h.studentService.GetAll(query.StudentQuery, query.PageQuery)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.Response{
+		c.JSON(listErrorStatus(err), dto.Response{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -129,18 +149,19 @@ func (h *StudentHandler) GetAll(c *gin.Context) {
 
 	responses := []dto.StudentResponse{}
 	for _, s := range students {
-		responses = append(responses, toStudentResponse(&s))
+		responses = append(responses, h.studentResponse(c, &s))
 	}
 
-	c.JSON(http.StatusOK, dto.Response{
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
 		Success: true,
 		Data:    responses,
+		Meta:    meta,
 	})
 }
 
 // Update godoc
 // @Summary 학생 수정
-// @Description 학생 정보 수정
+// @Description 학생 정보 수정 (생년월일, 연락처, 건강 메모, 비상 연락처 수정은 민감 정보 권한 필요. 생년월일, 연락처, 건강 메모는 빈 값을 보내면 삭제)
 // @Tags 학생
 // @Accept json
 // @Produce json
@@ -169,6 +190,15 @@ func (h *StudentHandler) Update(c *gin.Context) {
 		return
 	}
 
+	sensitive := req.BirthDate != nil || req.Phone != nil || req.MedicalNotes != nil || req.EmergencyContacts != nil
+	if sensitive && !h.canViewSensitive(c) {
+		c.JSON(http.StatusForbidden, dto.Response{
+			Success: false,
+			Error:   "insufficient permissions",
+		})
+		return
+	}
+
 	student, err := h.studentService.Update(id, req)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.Response{
@@ -178,12 +208,11 @@ func (h *StudentHandler) Update(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionUpdate, "student", &student.ID, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionUpdate, "student", &student.ID, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
-		Data:    toStudentResponse(student),
+		Data:    h.studentResponse(c, student),
 	})
 }
 
@@ -215,27 +244,266 @@ func (h *StudentHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionDelete, "student", &id, nil, c.ClientIP())
+	h.auditService.Log(actorFrom(c), model.AuditActionDelete, "student", &id, nil, c.ClientIP())
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
 	})
 }
 
-// Import godoc
-// @Summary CSV 일괄 등록
-// @Description CSV 파일로 학생 일괄 등록
+// Move godoc
+// @Summary 호실 이동
+// @Description 학생을 다른 호실/침대로 이동 (이전 배정은 종료되고 이력으로 남음)
+// @Tags 학생
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "학생 ID"
+// @Param request body dto.MoveStudentRequest true "이동 정보"
+// @Success 200 {object} dto.Response{data=dto.StudentResponse}
+// @Failure 400 {object} dto.Response
+// @Router /students/{id}/move [post]
+func (h *StudentHandler) Move(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	var req dto.MoveStudentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	student, err := h.studentService.Move(id, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionMoveStudent, "student", &student.ID, map[string]any{
+		"roomNumber": student.RoomNumber,
+		"bedId":      student.BedID,
+		"reason":     req.Reason,
+	}, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    h.studentResponse(c, student),
+	})
+}
+
+// GetAssignments godoc
+// @Summary 호실 배정 이력
+// @Description 학생의 호실 배정 이력 조회 (최근 순)
+// @Tags 학생
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "학생 ID"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (startedAt, endedAt, 내림차순은 - 접두사, 진행 중인 배정의 endedAt은 가장 늦은 값으로 취급)" default(-startedAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.RoomAssignmentResponse}
+// @Failure 400 {object} dto.Response
+// @Failure 404 {object} dto.Response
+// @Router /students/{id}/assignments [get]
+func (h *StudentHandler) GetAssignments(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	var page dto.PageQuery
+	if err := c.ShouldBindQuery(&page); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	assignments, meta, err := h.studentService.GetAssignments(id, page)
+	if err != nil {
+		status := listErrorStatus(err)
+		if errors.Is(err, service.ErrStudentNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.RoomAssignmentResponse{}
+	for _, a := range assignments {
+		responses = append(responses, toRoomAssignmentResponse(&a))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// GetDeleted godoc
+// @Summary 삭제된 학생 목록
+// @Description 삭제된 학생 목록 조회 (졸업생 제외, 최근 삭제 순)
+// @Tags 학생
+// @Produce json
+// @Security BearerAuth
+// @Param search query string false "이름 또는 학번 검색"
+// @Param grade query int false "학년"
+// @Param page query int false "페이지" default(1)
+// @Param limit query int false "페이지당 개수 (최대 100)" default(20)
+// @Param cursor query string false "다음 페이지 커서 (meta.nextCursor, 지정 시 page 무시)"
+// @Param sort query string false "정렬 (deletedAt, studentNumber, name, grade, 내림차순은 - 접두사)" default(-deletedAt)
+// @Success 200 {object} dto.PaginatedResponse{data=[]dto.StudentResponse}
+// @Failure 400 {object} dto.Response
+// @Router /students/deleted [get]
+func (h *StudentHandler) GetDeleted(c *gin.Context) {
+	var query dto.StudentListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	students, meta, err := h.studentService.GetDeleted(query.StudentQuery, query.PageQuery)
+	if err != nil {
+		c.JSON(listErrorStatus(err), dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	responses := []dto.StudentResponse{}
+	for _, s := range students {
+		responses = append(responses, h.studentResponse(c, &s))
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Success: true,
+		Data:    responses,
+		Meta:    meta,
+	})
+}
+
+// Restore godoc
+// @Summary 삭제된 학생 복구
+// @Description 삭제된 학생을 복구 (졸업생은 복구 불가). 같은 학번의 재학생이 있으면 실패하며, 이전 침대가 비어 있지 않으면 침대 배정 없이 복구
 // @Tags 학생
-// @Accept multipart/form-data
 // @Produce json
 // @Security BearerAuth
-// @Param file formData file true "CSV 파일"
+// @Param id path string true "학생 ID"
+// @Success 200 {object} dto.Response{data=dto.StudentResponse}
+// @Failure 400 {object} dto.Response
+// @Router /students/{id}/restore [post]
+func (h *StudentHandler) Restore(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	student, err := h.studentService.Restore(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionRestoreStudent, "student", &student.ID, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+		Data:    h.studentResponse(c, student),
+	})
+}
+
+// Purge godoc
+// @Summary 학생 영구 삭제
+// @Description 삭제된 학생을 영구 삭제 (졸업생 제외, 보호자 포함). 호실 배정 이력은 학생 연결만 끊고 학번과 이름을 남긴 채 보존. 상벌점은 STUDENT_PURGE_POINTS 설정에 따라 함께 삭제하거나 영구 삭제를 거부
+// @Tags 학생
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "학생 ID"
 // @Success 200 {object} dto.Response
 // @Failure 400 {object} dto.Response
+// @Router /students/{id}/purge [delete]
+func (h *StudentHandler) Purge(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   "invalid student id",
+		})
+		return
+	}
+
+	if err := h.studentService.Purge(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionPurgeStudent, "student", &id, nil, c.ClientIP())
+
+	c.JSON(http.StatusOK, dto.Response{
+		Success: true,
+	})
+}
+
+// Import godoc
+// @Summary CSV/XLSX 일괄 등록
+// @Description CSV(UTF-8, EUC-KR) 또는 XLSX 파일로 학생 일괄 등록/수정 (학번 기준, 헤더로 열 구분). 오류가 있는 행이 하나라도 있으면 아무것도 반영하지 않음
+// @Tags 학생
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "CSV 또는 XLSX 파일"
+// @Param sheet formData string false "XLSX 시트 이름 (기본: 첫 번째 시트)"
+// @Param dryRun formData bool false "검증만 하고 반영하지 않음"
+// @Success 200 {object} dto.Response{data=dto.StudentImportResponse}
+// @Failure 400 {object} dto.Response{details=dto.StudentImportResponse}
 // @Router /students/import [post]
 func (h *StudentHandler) Import(c *gin.Context) {
-	file, _, err := c.Request.FormFile("file")
+	var req dto.ImportStudentsRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.Response{
 			Success: false,
@@ -245,31 +513,140 @@ func (h *StudentHandler) Import(c *gin.Context) {
 	}
 	defer file.Close()
 
-	count, err := h.studentService.ImportCSV(file)
-	if err != nil {
+	format := "csv"
+	switch strings.ToLower(filepath.Ext(header.Filename)) {
+	case ".xlsx":
+		format = "xlsx"
+	case ".xls":
 		c.JSON(http.StatusBadRequest, dto.Response{
 			Success: false,
-			Error:   err.Error(),
+			Error:   "xls files are not supported, save the file as xlsx or csv",
 		})
 		return
 	}
 
-	userID := c.MustGet("userID").(uuid.UUID)
-	h.auditService.Log(userID, model.AuditActionCreate, "student", nil, map[string]int{"count": count}, c.ClientIP())
+	report, err := h.studentService.Import(file, format, req)
+	if err != nil {
+		resp := dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		}
+		if report != nil {
+			resp.Details = report
+		}
+		c.JSON(http.StatusBadRequest, resp)
+		return
+	}
+
+	if !report.DryRun {
+		h.auditService.Log(actorFrom(c), model.AuditActionImportStudents, "student", nil, map[string]int{
+			"created": report.Created,
+			"updated": report.Updated,
+			"skipped": report.Skipped,
+		}, c.ClientIP())
+	}
 
 	c.JSON(http.StatusOK, dto.Response{
 		Success: true,
-		Data:    map[string]int{"imported": count},
+		Data:    report,
 	})
 }
 
+// Export godoc
+// @Summary 학생 목록 내보내기
+// @Description 검색 조건에 맞는 학생 목록을 XLSX 또는 CSV(UTF-8) 파일로 내보내기. 내보낸 파일은 그대로 일괄 등록에 사용할 수 있음
+// @Tags 학생
+// @Produce octet-stream
+// @Security BearerAuth
+// @Param format query string false "파일 형식 (xlsx, csv)" default(xlsx)
+// @Param search query string false "이름 또는 학번 검색"
+// @Param grade query int false "학년"
+// @Param buildingId query string false "건물 ID"
+// @Param room query string false "호실"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.Response
+// @Router /students/export [get]
+func (h *StudentHandler) Export(c *gin.Context) {
+	var query dto.ExportStudentsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+	if query.Format == "" {
+		query.Format = "xlsx"
+	}
+
+	data, count, err := h.studentService.Export(query.StudentQuery, query.Format)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Response{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.auditService.Log(actorFrom(c), model.AuditActionExportStudents, "student", nil, map[string]any{
+		"format": query.Format,
+		"count":  count,
+	}, c.ClientIP())
+
+	contentType := "text/csv; charset=utf-8"
+	if query.Format == "xlsx" {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	filename := fmt.Sprintf("students-%s.%s", time.Now().Format("20060102"), query.Format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, contentType, data)
+}
+
 func toStudentResponse(s *model.Student) dto.StudentResponse {
-	return dto.StudentResponse{
+	resp := dto.StudentResponse{
 		ID:            s.ID,
 		StudentNumber: s.StudentNumber,
 		Name:          s.Name,
 		RoomNumber:    s.RoomNumber,
+		BedID:         s.BedID,
 		Grade:         s.Grade,
+		Gender:        string(s.Gender),
+		HomeroomClass: s.HomeroomClass,
+		Status:        string(s.Status),
+		GraduatedAt:   s.GraduatedAt,
 		CreatedAt:     s.CreatedAt,
 	}
+	if s.DeletedAt.Valid {
+		resp.DeletedAt = &s.DeletedAt.Time
+	}
+	return resp
+}
+
+func (h *StudentHandler) studentResponse(c *gin.Context, s *model.Student) dto.StudentResponse {
+	resp := toStudentResponse(s)
+	if !h.canViewSensitive(c) {
+		return resp
+	}
+
+	profile := &dto.StudentProfileResponse{
+		Phone:             s.Phone,
+		MedicalNotes:      s.MedicalNotes,
+		EmergencyContacts: []dto.EmergencyContactResponse{},
+	}
+	if s.BirthDate != nil {
+		profile.BirthDate = s.BirthDate.Format("2006-01-02")
+	}
+	for _, ec := range s.EmergencyContacts {
+		profile.EmergencyContacts = append(profile.EmergencyContacts, dto.EmergencyContactResponse{
+			Name:         ec.Name,
+			Relationship: ec.Relationship,
+			Phone:        ec.Phone,
+		})
+	}
+	resp.Profile = profile
+	return resp
+}
+
+func (h *StudentHandler) canViewSensitive(c *gin.Context) bool {
+	return middleware.HasPermission(c, h.permissionService, model.PermissionStudentsSensitive)
 }
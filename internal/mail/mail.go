@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"errors"
+	"fmt"
+
+	"dormi-api/internal/config"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(msg Message) error
+}
+
+func New(cfg *config.Config) (Mailer, error) {
+	switch cfg.MailDriver {
+	case "":
+		if cfg.SMTPHost == "" {
+			return nil, nil
+		}
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom), nil
+	case "smtp":
+		if cfg.SMTPHost == "" {
+			return nil, errors.New("SMTP_HOST is required when MAIL_DRIVER is smtp")
+		}
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom), nil
+	case "memory":
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("unknown MAIL_DRIVER: %s (allowed: smtp, memory)", cfg.MailDriver)
+	}
+}
@@ -0,0 +1,14 @@
+package mail
+
+import "log"
+
+type MemoryMailer struct{}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(msg Message) error {
+	log.Printf("mail to=%s subject=%q (memory driver, not delivered)\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
@@ -5,13 +5,40 @@ import (
 	"strings"
 
 	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
 	"dormi-api/internal/service"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
-func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
+var mfaEnrollmentPaths = map[string]bool{
+	"/api/auth/me":         true,
+	"/api/auth/2fa":        true,
+	"/api/auth/2fa/setup":  true,
+	"/api/auth/2fa/enable": true,
+	"/api/auth/logout":     true,
+	"/api/auth/logout-all": true,
+}
+
+var passwordChangeRoutes = map[string]bool{
+	"GET /api/auth/me":         true,
+	"PATCH /api/auth/password": true,
+	"POST /api/auth/logout":    true,
+}
+
+var impersonationBlockedPaths = map[string]bool{
+	"/api/auth/password":           true,
+	"/api/auth/me/email":           true,
+	"/api/auth/logout-all":         true,
+	"/api/auth/2fa/setup":          true,
+	"/api/auth/2fa/enable":         true,
+	"/api/auth/2fa/disable":        true,
+	"/api/auth/2fa/recovery-codes": true,
+	"/api/users/:id/impersonate":   true,
+}
+
+func AuthMiddleware(authService *service.AuthService, apiKeyService *service.APIKeyService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -24,6 +51,11 @@ func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
 		}
 
 		parts := strings.Split(authHeader, " ")
+		if len(parts) == 2 && parts[0] == "ApiKey" {
+			authenticateAPIKey(c, apiKeyService, parts[1])
+			return
+		}
+
 		if len(parts) != 2 || parts[0] != "Bearer" {
 			c.JSON(http.StatusUnauthorized, dto.Response{
 				Success: false,
@@ -33,7 +65,7 @@ func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
 			return
 		}
 
-		claims, err := authService.ValidateToken(parts[1])
+		claims, session, err := authService.ValidateToken(parts[1], c.ClientIP())
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, dto.Response{
 				Success: false,
@@ -53,9 +85,82 @@ func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
 			return
 		}
 
+		sessionID, err := uuid.Parse(claims.SessionID)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, dto.Response{
+				Success: false,
+				Error:   "invalid session id in token",
+			})
+			c.Abort()
+			return
+		}
+
+		user := session.User
+		if session.ImpersonatorID != nil {
+			if impersonationBlockedPaths[c.FullPath()] {
+				c.JSON(http.StatusForbidden, dto.Response{
+					Success: false,
+					Error:   "not allowed while impersonating",
+				})
+				c.Abort()
+				return
+			}
+			c.Set("impersonatorID", *session.ImpersonatorID)
+		} else if authService.PasswordChangeRequired(user, session.AuthMethod) {
+			if !passwordChangeRoutes[c.Request.Method+" "+c.FullPath()] {
+				c.JSON(http.StatusForbidden, dto.Response{
+					Success: false,
+					Error:   "password change required",
+				})
+				c.Abort()
+				return
+			}
+		} else if authService.MFAEnrollmentRequired(user) && !mfaEnrollmentPaths[c.FullPath()] {
+			c.JSON(http.StatusForbidden, dto.Response{
+				Success: false,
+				Error:   "two-factor authentication enrollment required",
+			})
+			c.Abort()
+			return
+		}
+
 		c.Set("userID", userID)
-		c.Set("userEmail", claims.Email)
-		c.Set("userRole", claims.Role)
+		c.Set("sessionID", sessionID)
+		c.Set("userEmail", user.Email)
+		c.Set("userRole", user.Role)
 		c.Next()
 	}
 }
+
+func authenticateAPIKey(c *gin.Context, apiKeyService *service.APIKeyService, rawKey string) {
+	key, err := apiKeyService.Authenticate(rawKey)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, dto.Response{
+			Success: false,
+			Error:   "invalid or expired api key",
+		})
+		c.Abort()
+		return
+	}
+
+	if strings.HasPrefix(c.FullPath(), "/api/auth/") {
+		c.JSON(http.StatusForbidden, dto.Response{
+			Success: false,
+			Error:   "api keys cannot access this endpoint",
+		})
+		c.Abort()
+		return
+	}
+
+	scopes := make([]model.Permission, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, model.Permission(scope))
+	}
+
+	c.Set("userID", key.User.ID)
+	c.Set("apiKeyID", key.ID)
+	c.Set("apiKeyScopes", scopes)
+	c.Set("userEmail", key.User.Email)
+	c.Set("userRole", key.User.Role)
+	c.Next()
+}
@@ -2,17 +2,18 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/service"
 
 	"github.com/gin-gonic/gin"
 )
 
-func RequireRole(roles ...model.Role) gin.HandlerFunc {
+func RequirePermission(permissionService *service.PermissionService, permissions ...model.Permission) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		userRole, exists := c.Get("userRole")
-		if !exists {
+		if _, exists := c.Get("userRole"); !exists {
 			c.JSON(http.StatusUnauthorized, dto.Response{
 				Success: false,
 				Error:   "unauthorized",
@@ -21,26 +22,27 @@ func RequireRole(roles ...model.Role) gin.HandlerFunc {
 			return
 		}
 
-		role := userRole.(model.Role)
-		for _, r := range roles {
-			if role == r {
-				c.Next()
+		for _, p := range permissions {
+			if !HasPermission(c, permissionService, p) {
+				c.JSON(http.StatusForbidden, dto.Response{
+					Success: false,
+					Error:   "insufficient permissions",
+				})
+				c.Abort()
 				return
 			}
 		}
 
-		c.JSON(http.StatusForbidden, dto.Response{
-			Success: false,
-			Error:   "insufficient permissions",
-		})
-		c.Abort()
+		c.Next()
 	}
 }
 
-func RequireAdmin() gin.HandlerFunc {
-	return RequireRole(model.RoleAdmin)
-}
+func HasPermission(c *gin.Context, permissionService *service.PermissionService, permission model.Permission) bool {
+	userRole, exists := c.Get("userRole")
+	if !exists || !permissionService.HasPermission(userRole.(model.Role), permission) {
+		return false
+	}
 
-func RequireAdminOrSupervisor() gin.HandlerFunc {
-	return RequireRole(model.RoleAdmin, model.RoleSupervisor)
+	scopes, hasScopes := c.Get("apiKeyScopes")
+	return !hasScopes || slices.Contains(scopes.([]model.Permission), permission)
 }
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type AllocationPlanStatus string
+
+const (
+	AllocationPlanStatusDraft     AllocationPlanStatus = "DRAFT"
+	AllocationPlanStatusCommitted AllocationPlanStatus = "COMMITTED"
+)
+
+type RoommateRequest struct {
+	StudentID  uuid.UUID `json:"studentId"`
+	RoommateID uuid.UUID `json:"roommateId"`
+}
+
+type AllocationPlan struct {
+	ID               uuid.UUID                            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name             string                               `gorm:"type:varchar(100);not null"`
+	Status           AllocationPlanStatus                 `gorm:"type:varchar(20);not null;default:'DRAFT';index"`
+	GroupByGrade     bool                                 `gorm:"not null;default:false"`
+	KeepCurrentRoom  bool                                 `gorm:"not null;default:false"`
+	RoommateRequests datatypes.JSONSlice[RoommateRequest] `gorm:"type:jsonb"`
+	CreatedBy        *uuid.UUID                           `gorm:"type:uuid"`
+	CreatedByUser    *User                                `gorm:"foreignKey:CreatedBy"`
+	CommittedAt      *time.Time
+	Items            []AllocationItem   `gorm:"foreignKey:PlanID"`
+	Summary          *AllocationSummary `gorm:"-"`
+	CreatedAt        time.Time          `gorm:"index"`
+	UpdatedAt        time.Time
+}
+
+type AllocationSummary struct {
+	Students   int
+	Assigned   int
+	Unassigned int
+	Moved      int
+}
+
+type AllocationItem struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	PlanID    uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_allocation_items_plan_student"`
+	Plan      *AllocationPlan `gorm:"foreignKey:PlanID;constraint:OnDelete:CASCADE"`
+	StudentID uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_allocation_items_plan_student"`
+	Student   *Student        `gorm:"foreignKey:StudentID;constraint:OnDelete:CASCADE"`
+	BedID     *uuid.UUID      `gorm:"type:uuid"`
+	Bed       *Bed            `gorm:"foreignKey:BedID;constraint:OnDelete:SET NULL"`
+	Fixed     bool            `gorm:"not null;default:false"`
+	Note      string          `gorm:"type:varchar(255)"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type APIKey struct {
+	ID         uuid.UUID                   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID     uuid.UUID                   `gorm:"type:uuid;not null;index"`
+	User       *User                       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	Name       string                      `gorm:"type:varchar(100);not null"`
+	Prefix     string                      `gorm:"type:varchar(20);not null"`
+	KeyHash    string                      `gorm:"type:varchar(64);uniqueIndex;not null"`
+	Scopes     datatypes.JSONSlice[string] `gorm:"type:jsonb"`
+	CreatedBy  uuid.UUID                   `gorm:"type:uuid;not null"`
+	LastUsedAt *time.Time
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
@@ -10,26 +10,60 @@ import (
 type AuditAction string
 
 const (
-	AuditActionCreate              AuditAction = "CREATE"
-	AuditActionUpdate              AuditAction = "UPDATE"
-	AuditActionDelete              AuditAction = "DELETE"
-	AuditActionLogin               AuditAction = "LOGIN"
-	AuditActionGivePoint           AuditAction = "GIVE_POINT"
-	AuditActionCancelPoint         AuditAction = "CANCEL_POINT"
-	AuditActionResetPoints         AuditAction = "RESET_POINTS"
-	AuditActionRequestDutySwap     AuditAction = "REQUEST_DUTY_SWAP"
-	AuditActionApproveDutySwap     AuditAction = "APPROVE_DUTY_SWAP"
-	AuditActionRejectDutySwap      AuditAction = "REJECT_DUTY_SWAP"
+	AuditActionCreate               AuditAction = "CREATE"
+	AuditActionUpdate               AuditAction = "UPDATE"
+	AuditActionDelete               AuditAction = "DELETE"
+	AuditActionLogin                AuditAction = "LOGIN"
+	AuditActionLoginFailed          AuditAction = "LOGIN_FAILED"
+	AuditActionAccountLocked        AuditAction = "ACCOUNT_LOCKED"
+	AuditActionUnlockAccount        AuditAction = "UNLOCK_ACCOUNT"
+	AuditActionDeactivateUser       AuditAction = "DEACTIVATE_USER"
+	AuditActionReactivateUser       AuditAction = "REACTIVATE_USER"
+	AuditActionImpersonateUser      AuditAction = "IMPERSONATE_USER"
+	AuditActionMoveStudent          AuditAction = "MOVE_STUDENT"
+	AuditActionImportStudents       AuditAction = "IMPORT_STUDENTS"
+	AuditActionExportStudents       AuditAction = "EXPORT_STUDENTS"
+	AuditActionAcademicYearRollover AuditAction = "ACADEMIC_YEAR_ROLLOVER"
+	AuditActionRestoreStudent       AuditAction = "RESTORE_STUDENT"
+	AuditActionPurgeStudent         AuditAction = "PURGE_STUDENT"
+	AuditActionCommitAllocation     AuditAction = "COMMIT_ALLOCATION"
+	AuditActionBackfillBeds         AuditAction = "BACKFILL_BEDS"
+	AuditActionLogout               AuditAction = "LOGOUT"
+	AuditActionTerminateSession     AuditAction = "TERMINATE_SESSION"
+	AuditActionEnableMFA            AuditAction = "ENABLE_MFA"
+	AuditActionDisableMFA           AuditAction = "DISABLE_MFA"
+	AuditActionRegenerateMFACodes   AuditAction = "REGENERATE_MFA_CODES"
+	AuditActionRequestPasswordReset AuditAction = "REQUEST_PASSWORD_RESET"
+	AuditActionResetPassword        AuditAction = "RESET_PASSWORD"
+	AuditActionRequestEmailChange   AuditAction = "REQUEST_EMAIL_CHANGE"
+	AuditActionChangeEmail          AuditAction = "CHANGE_EMAIL"
+	AuditActionUpdatePermissions    AuditAction = "UPDATE_PERMISSIONS"
+	AuditActionCreateAPIKey         AuditAction = "CREATE_API_KEY"
+	AuditActionRevokeAPIKey         AuditAction = "REVOKE_API_KEY"
+	AuditActionRotateSigningKey     AuditAction = "ROTATE_SIGNING_KEY"
+	AuditActionGivePoint            AuditAction = "GIVE_POINT"
+	AuditActionCancelPoint          AuditAction = "CANCEL_POINT"
+	AuditActionResetPoints          AuditAction = "RESET_POINTS"
+	AuditActionProposePoint         AuditAction = "PROPOSE_POINT"
+	AuditActionApprovePointProposal AuditAction = "APPROVE_POINT_PROPOSAL"
+	AuditActionRejectPointProposal  AuditAction = "REJECT_POINT_PROPOSAL"
+	AuditActionRequestDutySwap      AuditAction = "REQUEST_DUTY_SWAP"
+	AuditActionApproveDutySwap      AuditAction = "APPROVE_DUTY_SWAP"
+	AuditActionRejectDutySwap       AuditAction = "REJECT_DUTY_SWAP"
 )
 
 type AuditLog struct {
-	ID         uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	UserID     uuid.UUID      `gorm:"type:uuid;not null;index"`
-	User       *User          `gorm:"foreignKey:UserID"`
-	Action     AuditAction    `gorm:"type:varchar(50);not null"`
-	EntityType string         `gorm:"type:varchar(50)"`
-	EntityID   *uuid.UUID     `gorm:"type:uuid"`
-	Details    datatypes.JSON `gorm:"type:jsonb"`
-	IPAddress  string         `gorm:"type:varchar(45)"`
-	CreatedAt  time.Time      `gorm:"index"`
+	ID             uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID         *uuid.UUID     `gorm:"type:uuid;index"`
+	User           *User          `gorm:"foreignKey:UserID"`
+	APIKeyID       *uuid.UUID     `gorm:"type:uuid;index"`
+	APIKey         *APIKey        `gorm:"foreignKey:APIKeyID"`
+	ImpersonatorID *uuid.UUID     `gorm:"type:uuid;index"`
+	Impersonator   *User          `gorm:"foreignKey:ImpersonatorID"`
+	Action         AuditAction    `gorm:"type:varchar(50);not null"`
+	EntityType     string         `gorm:"type:varchar(50)"`
+	EntityID       *uuid.UUID     `gorm:"type:uuid"`
+	Details        datatypes.JSON `gorm:"type:jsonb"`
+	IPAddress      string         `gorm:"type:varchar(45)"`
+	CreatedAt      time.Time      `gorm:"index"`
 }
@@ -14,12 +14,13 @@ const (
 )
 
 type Duty struct {
-	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Type       DutyType  `gorm:"type:varchar(20);not null"`
-	Date       time.Time `gorm:"type:date;not null;index"`
-	Floor      *int      `gorm:"type:int"`
-	AssigneeID uuid.UUID `gorm:"type:uuid;not null;index"`
-	Assignee   *User     `gorm:"foreignKey:AssigneeID"`
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Type       DutyType   `gorm:"type:varchar(20);not null"`
+	Date       time.Time  `gorm:"type:date;not null;index"`
+	Floor      *int       `gorm:"type:int"`
+	FloorID    *uuid.UUID `gorm:"type:uuid;index"`
+	AssigneeID uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Assignee   *User      `gorm:"foreignKey:AssigneeID"`
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
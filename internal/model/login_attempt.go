@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type LoginAttempt struct {
+	Key           string `gorm:"type:varchar(300);primaryKey"`
+	Failures      int    `gorm:"not null;default:0"`
+	Lockouts      int    `gorm:"not null;default:0"`
+	LockedUntil   *time.Time
+	LastFailureAt time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,8 @@
+package model
+
+import "time"
+
+type AppliedMigration struct {
+	Name      string `gorm:"type:varchar(100);primaryKey"`
+	CreatedAt time.Time
+}
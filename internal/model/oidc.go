@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OIDCAuthRequest struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Provider     string    `gorm:"type:varchar(50);not null"`
+	StateHash    string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	BindingHash  string    `gorm:"type:varchar(64);not null;default:''"`
+	CodeVerifier string    `gorm:"type:varchar(128);not null"`
+	Nonce        string    `gorm:"type:varchar(128);not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	UsedAt       *time.Time
+	CreatedAt    time.Time
+}
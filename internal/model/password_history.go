@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasswordHistory struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	User         *User     `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	PasswordHash string    `gorm:"not null"`
+	CreatedAt    time.Time
+}
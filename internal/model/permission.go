@@ -0,0 +1,108 @@
+package model
+
+import "time"
+
+type Permission string
+
+const (
+	PermissionUsersManage       Permission = "users:manage"
+	PermissionUsersImpersonate  Permission = "users:impersonate"
+	PermissionStudentsRead      Permission = "students:read"
+	PermissionStudentsWrite     Permission = "students:write"
+	PermissionStudentsSensitive Permission = "students:sensitive"
+	PermissionStudentsImport    Permission = "students:import"
+	PermissionStudentsExport    Permission = "students:export"
+	PermissionStudentsRollover  Permission = "students:rollover"
+	PermissionStudentsPurge     Permission = "students:purge"
+	PermissionPointReasonsRead  Permission = "point_reasons:read"
+	PermissionPointReasonsWrite Permission = "point_reasons:write"
+	PermissionPointsRead        Permission = "points:read"
+	PermissionPointsGive        Permission = "points:give"
+	PermissionPointsCancel      Permission = "points:cancel"
+	PermissionPointsReset       Permission = "points:reset"
+	PermissionPointsPropose     Permission = "points:propose"
+	PermissionPointsReview      Permission = "points:review"
+	PermissionRoomsRead         Permission = "rooms:read"
+	PermissionRoomsManage       Permission = "rooms:manage"
+	PermissionAllocationsManage Permission = "allocations:manage"
+	PermissionDutiesRead        Permission = "duties:read"
+	PermissionDutiesWrite       Permission = "duties:write"
+	PermissionDutiesSwap        Permission = "duties:swap"
+	PermissionAuditRead         Permission = "audit:read"
+	PermissionPermissionsManage Permission = "permissions:manage"
+	PermissionAPIKeysManage     Permission = "api_keys:manage"
+	PermissionSigningKeysManage Permission = "signing_keys:manage"
+)
+
+var AllPermissions = []Permission{
+	PermissionUsersManage,
+	PermissionUsersImpersonate,
+	PermissionStudentsRead,
+	PermissionStudentsWrite,
+	PermissionStudentsSensitive,
+	PermissionStudentsImport,
+	PermissionStudentsExport,
+	PermissionStudentsRollover,
+	PermissionStudentsPurge,
+	PermissionPointReasonsRead,
+	PermissionPointReasonsWrite,
+	PermissionPointsRead,
+	PermissionPointsGive,
+	PermissionPointsCancel,
+	PermissionPointsReset,
+	PermissionPointsPropose,
+	PermissionPointsReview,
+	PermissionRoomsRead,
+	PermissionRoomsManage,
+	PermissionAllocationsManage,
+	PermissionDutiesRead,
+	PermissionDutiesWrite,
+	PermissionDutiesSwap,
+	PermissionAuditRead,
+	PermissionPermissionsManage,
+	PermissionAPIKeysManage,
+	PermissionSigningKeysManage,
+}
+
+var AllRoles = []Role{RoleAdmin, RoleSupervisor, RoleCouncil}
+
+var DefaultPermissionRoles = map[Permission][]Role{
+	PermissionUsersManage:       {RoleAdmin},
+	PermissionUsersImpersonate:  {RoleAdmin},
+	PermissionStudentsRead:      {RoleAdmin, RoleSupervisor, RoleCouncil},
+	PermissionStudentsWrite:     {RoleAdmin, RoleSupervisor},
+	PermissionStudentsSensitive: {RoleAdmin, RoleSupervisor},
+	PermissionStudentsImport:    {RoleAdmin, RoleSupervisor},
+	PermissionStudentsExport:    {RoleAdmin, RoleSupervisor},
+	PermissionStudentsRollover:  {RoleAdmin},
+	PermissionStudentsPurge:     {RoleAdmin},
+	PermissionPointReasonsRead:  {RoleAdmin, RoleSupervisor, RoleCouncil},
+	PermissionPointReasonsWrite: {RoleAdmin, RoleSupervisor},
+	PermissionPointsRead:        {RoleAdmin, RoleSupervisor, RoleCouncil},
+	PermissionPointsGive:        {RoleAdmin, RoleSupervisor},
+	PermissionPointsCancel:      {RoleAdmin, RoleSupervisor},
+	PermissionPointsReset:       {RoleAdmin},
+	PermissionPointsPropose:     {RoleCouncil},
+	PermissionPointsReview:      {RoleAdmin, RoleSupervisor},
+	PermissionRoomsRead:         {RoleAdmin, RoleSupervisor, RoleCouncil},
+	PermissionRoomsManage:       {RoleAdmin, RoleSupervisor},
+	PermissionAllocationsManage: {RoleAdmin, RoleSupervisor},
+	PermissionDutiesRead:        {RoleAdmin, RoleSupervisor, RoleCouncil},
+	PermissionDutiesWrite:       {RoleAdmin, RoleSupervisor},
+	PermissionDutiesSwap:        {RoleAdmin, RoleSupervisor, RoleCouncil},
+	PermissionAuditRead:         {RoleAdmin},
+	PermissionPermissionsManage: {RoleAdmin},
+	PermissionAPIKeysManage:     {RoleAdmin},
+	PermissionSigningKeysManage: {RoleAdmin},
+}
+
+type RolePermission struct {
+	Role       Role       `gorm:"type:varchar(20);primaryKey"`
+	Permission Permission `gorm:"type:varchar(100);primaryKey"`
+	CreatedAt  time.Time
+}
+
+type SeededPermission struct {
+	Permission Permission `gorm:"type:varchar(100);primaryKey"`
+	CreatedAt  time.Time
+}
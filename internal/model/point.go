@@ -14,15 +14,19 @@ const (
 )
 
 type Point struct {
-	ID          uuid.UUID    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	StudentID   uuid.UUID    `gorm:"type:uuid;not null;index"`
-	Student     *Student     `gorm:"foreignKey:StudentID"`
-	ReasonID    uuid.UUID    `gorm:"type:uuid;not null;index"`
-	Reason      *PointReason `gorm:"foreignKey:ReasonID"`
-	GivenBy     uuid.UUID    `gorm:"type:uuid;not null"`
-	GivenByUser *User        `gorm:"foreignKey:GivenBy"`
-	GivenAt     time.Time    `gorm:"not null"`
-	Cancelled   bool         `gorm:"default:false"`
-	CancelledAt *time.Time
-	CancelledBy *uuid.UUID `gorm:"type:uuid"`
+	ID             uuid.UUID    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StudentID      uuid.UUID    `gorm:"type:uuid;not null;index"`
+	Student        *Student     `gorm:"foreignKey:StudentID"`
+	ReasonID       uuid.UUID    `gorm:"type:uuid;not null;index"`
+	Reason         *PointReason `gorm:"foreignKey:ReasonID"`
+	GivenBy        uuid.UUID    `gorm:"type:uuid;not null"`
+	GivenByUser    *User        `gorm:"foreignKey:GivenBy"`
+	GivenAt        time.Time    `gorm:"not null"`
+	ProposedBy     *uuid.UUID   `gorm:"type:uuid"`
+	ProposedByUser *User        `gorm:"foreignKey:ProposedBy"`
+	ProposalID     *uuid.UUID   `gorm:"type:uuid;index"`
+	Cancelled      bool         `gorm:"default:false"`
+	CancelledAt    *time.Time
+	CancelledBy    *uuid.UUID `gorm:"type:uuid"`
+	ArchivedAt     *time.Time `gorm:"index"`
 }
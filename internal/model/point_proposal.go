@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PointProposalStatus string
+
+const (
+	PointProposalStatusPending  PointProposalStatus = "PENDING"
+	PointProposalStatusApproved PointProposalStatus = "APPROVED"
+	PointProposalStatusRejected PointProposalStatus = "REJECTED"
+)
+
+type PointProposal struct {
+	ID             uuid.UUID           `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StudentID      uuid.UUID           `gorm:"type:uuid;not null;index"`
+	Student        *Student            `gorm:"foreignKey:StudentID"`
+	ReasonID       uuid.UUID           `gorm:"type:uuid;not null"`
+	Reason         *PointReason        `gorm:"foreignKey:ReasonID"`
+	ProposedBy     uuid.UUID           `gorm:"type:uuid;not null;index"`
+	ProposedByUser *User               `gorm:"foreignKey:ProposedBy"`
+	Note           string              `gorm:"type:varchar(500)"`
+	Status         PointProposalStatus `gorm:"type:varchar(20);not null;default:'PENDING';index"`
+	ReviewedBy     *uuid.UUID          `gorm:"type:uuid"`
+	ReviewedByUser *User               `gorm:"foreignKey:ReviewedBy"`
+	ReviewedAt     *time.Time
+	ReviewComment  string     `gorm:"type:varchar(500)"`
+	PointID        *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt      time.Time  `gorm:"index"`
+	UpdatedAt      time.Time
+}
@@ -7,10 +7,11 @@ import (
 )
 
 type PointReason struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Name      string    `gorm:"type:varchar(100);not null"`
-	Type      PointType `gorm:"type:varchar(20);not null"`
-	Score     int       `gorm:"not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	Type           PointType `gorm:"type:varchar(20);not null"`
+	Score          int       `gorm:"not null"`
+	CouncilAllowed bool      `gorm:"default:false"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Building struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name      string    `gorm:"type:varchar(100);uniqueIndex;not null"`
+	Floors    []Floor   `gorm:"foreignKey:BuildingID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Floor struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	BuildingID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_floors_building_number"`
+	Building   *Building `gorm:"foreignKey:BuildingID;constraint:OnDelete:CASCADE"`
+	Number     int       `gorm:"not null;uniqueIndex:idx_floors_building_number"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type Room struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	FloorID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_rooms_floor_number"`
+	Floor     *Floor    `gorm:"foreignKey:FloorID;constraint:OnDelete:CASCADE"`
+	Number    string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_rooms_floor_number"`
+	Capacity  int       `gorm:"not null"`
+	Gender    Gender    `gorm:"type:varchar(10)"`
+	Beds      []Bed     `gorm:"foreignKey:RoomID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Bed struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	RoomID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_beds_room_label"`
+	Room      *Room     `gorm:"foreignKey:RoomID;constraint:OnDelete:CASCADE"`
+	Label     string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_beds_room_label"`
+	CreatedAt time.Time
+}
+
+func SameBed(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
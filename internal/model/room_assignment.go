@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoomAssignment struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StudentID     *uuid.UUID `gorm:"type:uuid;index"`
+	Student       *Student   `gorm:"foreignKey:StudentID;constraint:OnDelete:SET NULL"`
+	StudentNumber string     `gorm:"type:varchar(20);not null;default:''"`
+	StudentName   string     `gorm:"type:varchar(100);not null;default:''"`
+	RoomID        *uuid.UUID `gorm:"type:uuid;index"`
+	Room          *Room      `gorm:"foreignKey:RoomID;constraint:OnDelete:SET NULL"`
+	BedID         *uuid.UUID `gorm:"type:uuid"`
+	Bed           *Bed       `gorm:"foreignKey:BedID;constraint:OnDelete:SET NULL"`
+	RoomNumber    string     `gorm:"type:varchar(20);not null;index"`
+	BedLabel      string     `gorm:"type:varchar(10)"`
+	StartedAt     time.Time  `gorm:"not null"`
+	EndedAt       *time.Time `gorm:"index"`
+	Reason        string     `gorm:"type:varchar(255)"`
+	CreatedAt     time.Time
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuthMethodPassword      = "password"
+	AuthMethodImpersonation = "impersonation"
+)
+
+type Session struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null;index"`
+	User           *User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	ImpersonatorID *uuid.UUID `gorm:"type:uuid;index"`
+	Impersonator   *User      `gorm:"foreignKey:ImpersonatorID;constraint:OnDelete:CASCADE"`
+	AuthMethod     string     `gorm:"type:varchar(60);not null;default:'password'"`
+	UserAgent      string     `gorm:"type:varchar(500)"`
+	IPAddress      string     `gorm:"type:varchar(45)"`
+	LastSeenAt     time.Time
+	ExpiresAt      time.Time `gorm:"not null"`
+	RevokedAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type RefreshToken struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	SessionID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Session   *Session  `gorm:"foreignKey:SessionID;constraint:OnDelete:CASCADE"`
+	TokenHash string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+const (
+	SigningAlgorithmEdDSA = "EdDSA"
+	SigningAlgorithmRS256 = "RS256"
+)
+
+type SigningKey struct {
+	ID         string `gorm:"type:varchar(64);primaryKey"`
+	Algorithm  string `gorm:"type:varchar(10);not null"`
+	PrivateKey string `gorm:"type:text;not null"`
+	PublicKey  string `gorm:"type:text;not null"`
+	Active     bool   `gorm:"not null;default:false;index"`
+	RetiresAt  *time.Time
+	CreatedAt  time.Time
+}
@@ -4,16 +4,72 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
+type Gender string
+
+const (
+	GenderMale   Gender = "MALE"
+	GenderFemale Gender = "FEMALE"
+)
+
+type StudentStatus string
+
+const (
+	StudentStatusActive StudentStatus = "ACTIVE"
+	StudentStatusAlumni StudentStatus = "ALUMNI"
+)
+
+const FinalGrade = 3
+
+const (
+	PurgePointsDelete   = "delete"
+	PurgePointsRestrict = "restrict"
+)
+
+type EmergencyContact struct {
+	Name         string `json:"name"`
+	Relationship string `json:"relationship"`
+	Phone        string `json:"phone"`
+}
+
 type Student struct {
-	ID            uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	StudentNumber string         `gorm:"type:varchar(20);uniqueIndex;not null"`
-	Name          string         `gorm:"type:varchar(100);not null"`
-	RoomNumber    string         `gorm:"type:varchar(20);not null"`
-	Grade         int            `gorm:"not null"`
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	ID            uuid.UUID     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StudentNumber string        `gorm:"type:varchar(20);uniqueIndex:idx_students_student_number_active,where:deleted_at IS NULL;not null"`
+	Name          string        `gorm:"type:varchar(100);not null"`
+	RoomNumber    string        `gorm:"type:varchar(20);not null"`
+	Grade         int           `gorm:"not null"`
+	Gender        Gender        `gorm:"type:varchar(10)"`
+	HomeroomClass string        `gorm:"type:varchar(20)"`
+	BedID         *uuid.UUID    `gorm:"type:uuid;uniqueIndex:idx_students_bed_id,where:deleted_at IS NULL"`
+	Bed           *Bed          `gorm:"foreignKey:BedID;constraint:OnDelete:SET NULL"`
+	Status        StudentStatus `gorm:"type:varchar(20);not null;default:'ACTIVE';index"`
+	GraduatedAt   *time.Time
+
+	BirthDate         *time.Time                            `gorm:"type:date"`
+	Phone             string                                `gorm:"type:varchar(20)"`
+	MedicalNotes      string                                `gorm:"type:text"`
+	EmergencyContacts datatypes.JSONSlice[EmergencyContact] `gorm:"type:jsonb"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+type Guardian struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	StudentID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Student        *Student  `gorm:"foreignKey:StudentID;constraint:OnDelete:CASCADE"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	Relationship   string    `gorm:"type:varchar(30);not null"`
+	Phone          string    `gorm:"type:varchar(20);not null"`
+	Email          string    `gorm:"type:varchar(255)"`
+	IsPrimary      bool      `gorm:"not null;default:false"`
+	SMSConsent     bool      `gorm:"not null;default:false"`
+	EmailConsent   bool      `gorm:"not null;default:false"`
+	MedicalConsent bool      `gorm:"not null;default:false"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
@@ -14,12 +14,36 @@ const (
 	RoleCouncil    Role = "COUNCIL"
 )
 
+type UserStatus string
+
+const (
+	UserStatusActive   UserStatus = "ACTIVE"
+	UserStatusInactive UserStatus = "INACTIVE"
+)
+
 type User struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Email     string    `gorm:"type:varchar(255);uniqueIndex;not null"`
-	Password  string    `gorm:"type:varchar(255);not null"`
-	Name      string    `gorm:"type:varchar(100);not null"`
-	Role      Role      `gorm:"type:varchar(20);not null"`
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Email        string    `gorm:"type:varchar(255);uniqueIndex;not null"`
+	Password     string    `gorm:"type:varchar(255);not null"`
+	Name         string    `gorm:"type:varchar(100);not null"`
+	Role         Role      `gorm:"type:varchar(20);not null"`
+	TokenVersion int       `gorm:"not null;default:0"`
+
+	MustChangePassword bool `gorm:"not null;default:false"`
+
+	Status        UserStatus `gorm:"type:varchar(20);not null;default:'ACTIVE';index"`
+	DeactivatedAt *time.Time
+
+	ServiceAccount bool `gorm:"not null;default:false"`
+
+	TOTPSecret      string `gorm:"type:varchar(64)"`
+	TOTPEnabled     bool   `gorm:"not null;default:false"`
+	TOTPLastCounter int64  `gorm:"not null;default:0"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+func (u *User) IsActive() bool {
+	return u.Status != UserStatusInactive
+}
@@ -0,0 +1,159 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"dormi-api/internal/dto"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+type Error struct {
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+type Field[T any] struct {
+	Column string
+	Value  func(*T) interface{}
+}
+
+type Spec[T any] struct {
+	IDColumn    string
+	ID          func(*T) uuid.UUID
+	Fields      map[string]Field[T]
+	DefaultSort string
+}
+
+type cursor struct {
+	Sort  string      `json:"s"`
+	Value interface{} `json:"v"`
+	ID    uuid.UUID   `json:"id"`
+}
+
+func Find[T any](db *gorm.DB, query dto.PageQuery, spec Spec[T]) ([]T, *dto.Pagination, error) {
+	sortBy := query.Sort
+	if sortBy == "" {
+		sortBy = spec.DefaultSort
+	}
+	key, desc := strings.CutPrefix(sortBy, "-")
+	field, ok := spec.Fields[key]
+	if !ok {
+		return nil, nil, &Error{Message: fmt.Sprintf("invalid sort field: %s (allowed: %s)", key, allowedFields(spec.Fields))}
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+
+	var total int64
+	if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, nil, err
+	}
+
+	direction, op := "ASC", ">"
+	if desc {
+		direction, op = "DESC", "<"
+	}
+
+	tx := db.Session(&gorm.Session{})
+	if query.Cursor != "" {
+		c, err := decodeCursor(query.Cursor)
+		if err != nil || c.Sort != sortBy {
+			return nil, nil, &Error{Message: "invalid cursor"}
+		}
+		tx = tx.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", field.Column, spec.IDColumn, op), c.Value, c.ID)
+	} else {
+		tx = tx.Offset((page - 1) * limit)
+	}
+
+	var items []T
+	err := tx.Order(field.Column + " " + direction).
+		Order(spec.IDColumn + " " + direction).
+		Limit(limit + 1).
+		Find(&items).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meta := &dto.Pagination{
+		Limit:      limit,
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+		Sort:       sortBy,
+	}
+	if query.Cursor == "" {
+		meta.Page = page
+	}
+	if len(items) > limit {
+		items = items[:limit]
+		last := &items[limit-1]
+		meta.NextCursor = encodeCursor(cursor{Sort: sortBy, Value: field.Value(last), ID: spec.ID(last)})
+	}
+
+	return items, meta, nil
+}
+
+func encodeCursor(c cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var c cursor
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&c); err != nil {
+		return nil, err
+	}
+	if c.Value == nil || c.ID == uuid.Nil {
+		return nil, errors.New("incomplete cursor")
+	}
+
+	if n, ok := c.Value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			c.Value = i
+		} else if f, err := n.Float64(); err == nil {
+			c.Value = f
+		}
+	}
+
+	return &c, nil
+}
+
+func allowedFields[T any](fields map[string]Field[T]) string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
@@ -0,0 +1,218 @@
+package pagination
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"dormi-api/internal/dto"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type item struct {
+	ID        uuid.UUID
+	Name      string
+	Score     int
+	CreatedAt time.Time
+}
+
+var itemPage = Spec[item]{
+	IDColumn:    "id",
+	ID:          func(i *item) uuid.UUID { return i.ID },
+	DefaultSort: "-createdAt",
+	Fields: map[string]Field[item]{
+		"createdAt": {Column: "created_at", Value: func(i *item) interface{} { return i.CreatedAt }},
+		"name":      {Column: "name", Value: func(i *item) interface{} { return i.Name }},
+		"score":     {Column: "score", Value: func(i *item) interface{} { return i.Score }},
+	},
+}
+
+func dryRun(t *testing.T) (*gorm.DB, *[]string) {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var queries []string
+	err = db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		queries = append(queries, tx.Statement.SQL.String())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db.Model(&item{}), &queries
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	id := uuid.New()
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{name: "string", value: "kim", want: "kim"},
+		{name: "integer", value: 42, want: int64(42)},
+		{name: "float", value: 1.5, want: 1.5},
+		{name: "time", value: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC), want: "2026-03-01T09:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := encodeCursor(cursor{Sort: "-name", Value: tt.value, ID: id})
+			c, err := decodeCursor(encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Sort != "-name" || c.ID != id || c.Value != tt.want {
+				t.Errorf("got %+v, want sort -name, id %s, value %v (%T)", c, id, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "!!!"},
+		{name: "not json", cursor: "bm90IGpzb24"},
+		{name: "missing id", cursor: encodeCursor(cursor{Sort: "name", Value: "kim"})},
+		{name: "missing value", cursor: encodeCursor(cursor{Sort: "name", ID: uuid.New()})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCursor(tt.cursor); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestFindSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		sort      string
+		wantOrder string
+		wantErr   bool
+	}{
+		{name: "default", sort: "", wantOrder: "ORDER BY created_at DESC,id DESC"},
+		{name: "ascending", sort: "name", wantOrder: "ORDER BY name ASC,id ASC"},
+		{name: "descending", sort: "-score", wantOrder: "ORDER BY score DESC,id DESC"},
+		{name: "unknown field", sort: "password", wantErr: true},
+		{name: "raw column", sort: "created_at", wantErr: true},
+		{name: "injection", sort: "name;DROP TABLE items", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, queries := dryRun(t)
+			_, meta, err := Find(db, dto.PageQuery{Sort: tt.sort}, itemPage)
+
+			if tt.wantErr {
+				var pageErr *Error
+				if !errors.As(err, &pageErr) {
+					t.Fatalf("got %v, want a pagination error", err)
+				}
+				if !strings.Contains(pageErr.Message, "createdAt, name, score") {
+					t.Errorf("error %q does not list the allowed fields", pageErr.Message)
+				}
+				if len(*queries) != 0 {
+					t.Errorf("ran %d queries for an invalid sort", len(*queries))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if last := (*queries)[len(*queries)-1]; !strings.Contains(last, tt.wantOrder) {
+				t.Errorf("query %q does not contain %q", last, tt.wantOrder)
+			}
+			if tt.sort != "" && meta.Sort != tt.sort {
+				t.Errorf("got sort %q, want %q", meta.Sort, tt.sort)
+			}
+		})
+	}
+}
+
+func TestFindLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      dto.PageQuery
+		wantLimit  int
+		wantPage   int
+		wantSuffix string
+	}{
+		{name: "default", query: dto.PageQuery{}, wantLimit: DefaultLimit, wantPage: 1, wantSuffix: "LIMIT $1"},
+		{name: "negative", query: dto.PageQuery{Limit: -5, Page: -1}, wantLimit: DefaultLimit, wantPage: 1, wantSuffix: "LIMIT $1"},
+		{name: "within range", query: dto.PageQuery{Limit: 50, Page: 3}, wantLimit: 50, wantPage: 3, wantSuffix: "LIMIT $1 OFFSET $2"},
+		{name: "above maximum", query: dto.PageQuery{Limit: 1000}, wantLimit: MaxLimit, wantPage: 1, wantSuffix: "LIMIT $1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, queries := dryRun(t)
+			_, meta, err := Find(db, tt.query, itemPage)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if meta.Limit != tt.wantLimit || meta.Page != tt.wantPage {
+				t.Errorf("got limit %d page %d, want limit %d page %d", meta.Limit, meta.Page, tt.wantLimit, tt.wantPage)
+			}
+			if last := (*queries)[len(*queries)-1]; !strings.HasSuffix(last, tt.wantSuffix) {
+				t.Errorf("query %q does not end with %q", last, tt.wantSuffix)
+			}
+		})
+	}
+}
+
+func TestFindCursor(t *testing.T) {
+	valid := encodeCursor(cursor{Sort: "-score", Value: 10, ID: uuid.New()})
+
+	tests := []struct {
+		name      string
+		query     dto.PageQuery
+		wantWhere string
+		wantErr   bool
+	}{
+		{name: "continues after the cursor", query: dto.PageQuery{Sort: "-score", Cursor: valid}, wantWhere: "(score, id) < ($1, $2)"},
+		{name: "cursor from another sort", query: dto.PageQuery{Sort: "score", Cursor: valid}, wantErr: true},
+		{name: "malformed cursor", query: dto.PageQuery{Sort: "-score", Cursor: "garbage"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, queries := dryRun(t)
+			_, meta, err := Find(db, tt.query, itemPage)
+
+			if tt.wantErr {
+				var pageErr *Error
+				if !errors.As(err, &pageErr) || pageErr.Message != "invalid cursor" {
+					t.Fatalf("got %v, want invalid cursor", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			last := (*queries)[len(*queries)-1]
+			if !strings.Contains(last, tt.wantWhere) || strings.Contains(last, "OFFSET") {
+				t.Errorf("query %q does not continue from the cursor", last)
+			}
+			if meta.Page != 0 {
+				t.Errorf("got page %d for a cursor request, want 0", meta.Page)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package password
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"dormi-api/internal/config"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	ClassLower  = "lower"
+	ClassUpper  = "upper"
+	ClassDigit  = "digit"
+	ClassSymbol = "symbol"
+)
+
+var classNames = map[string]string{
+	ClassLower:  "lowercase",
+	ClassUpper:  "uppercase",
+	ClassDigit:  "digit",
+	ClassSymbol: "symbol",
+}
+
+//go:embed common.txt
+var commonList string
+
+var common = func() map[string]bool {
+	words := map[string]bool{}
+	for _, w := range strings.Split(commonList, "\n") {
+		if w = strings.TrimSpace(w); w != "" {
+			words[w] = true
+		}
+	}
+	return words
+}()
+
+type Violation struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type PolicyError struct {
+	Violations []Violation
+}
+
+func (e *PolicyError) Error() string {
+	return "password does not meet the password policy"
+}
+
+type Policy struct {
+	MinLength       int
+	RequiredClasses []string
+	HistorySize     int
+}
+
+func New(cfg *config.Config) *Policy {
+	var classes []string
+	for _, class := range cfg.PasswordRequiredClasses {
+		if class = strings.ToLower(class); classNames[class] != "" {
+			classes = append(classes, class)
+		}
+	}
+
+	return &Policy{
+		MinLength:       cfg.PasswordMinLength,
+		RequiredClasses: classes,
+		HistorySize:     cfg.PasswordHistorySize,
+	}
+}
+
+func (p *Policy) Validate(password, email string, previousHashes []string) error {
+	var violations []Violation
+
+	if len([]rune(password)) < p.MinLength {
+		violations = append(violations, Violation{
+			Code:    "too_short",
+			Message: fmt.Sprintf("password must be at least %d characters", p.MinLength),
+		})
+	}
+
+	for _, class := range p.RequiredClasses {
+		if !hasClass(password, class) {
+			violations = append(violations, Violation{
+				Code:    "missing_" + class,
+				Message: fmt.Sprintf("password must contain at least one %s character", classNames[class]),
+			})
+		}
+	}
+
+	lower := strings.ToLower(password)
+	if email != "" {
+		email = strings.ToLower(email)
+		local, _, _ := strings.Cut(email, "@")
+		if lower == email || lower == local {
+			violations = append(violations, Violation{
+				Code:    "matches_email",
+				Message: "password must not be the same as your email",
+			})
+		}
+	}
+
+	if common[lower] {
+		violations = append(violations, Violation{
+			Code:    "too_common",
+			Message: "password is too common",
+		})
+	}
+
+	if len(previousHashes) > p.HistorySize {
+		previousHashes = previousHashes[:p.HistorySize]
+	}
+	for _, hash := range previousHashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			violations = append(violations, Violation{
+				Code:    "reused",
+				Message: fmt.Sprintf("password must differ from your last %d passwords", p.HistorySize),
+			})
+			break
+		}
+	}
+
+	if len(violations) > 0 {
+		return &PolicyError{Violations: violations}
+	}
+	return nil
+}
+
+func hasClass(password, class string) bool {
+	for _, r := range password {
+		switch class {
+		case ClassLower:
+			if unicode.IsLower(r) {
+				return true
+			}
+		case ClassUpper:
+			if unicode.IsUpper(r) {
+				return true
+			}
+		case ClassDigit:
+			if unicode.IsDigit(r) {
+				return true
+			}
+		case ClassSymbol:
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package password
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"dormi-api/internal/config"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func hash(t *testing.T, password string) string {
+	t.Helper()
+	b, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestNew(t *testing.T) {
+	policy := New(&config.Config{
+		PasswordMinLength:       12,
+		PasswordRequiredClasses: []string{"Upper", "digit", "emoji", "SYMBOL"},
+		PasswordHistorySize:     3,
+	})
+
+	want := &Policy{
+		MinLength:       12,
+		RequiredClasses: []string{ClassUpper, ClassDigit, ClassSymbol},
+		HistorySize:     3,
+	}
+	if !reflect.DeepEqual(policy, want) {
+		t.Errorf("got %+v, want %+v", policy, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	history := []string{hash(t, "Previous#1"), hash(t, "Older#22"), hash(t, "Oldest#333")}
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		password string
+		email    string
+		history  []string
+		want     []string
+	}{
+		{
+			name:     "valid",
+			policy:   Policy{MinLength: 8},
+			password: "correct horse",
+		},
+		{
+			name:     "too short",
+			policy:   Policy{MinLength: 8},
+			password: "short",
+			want:     []string{"too_short"},
+		},
+		{
+			name:     "length counts characters not bytes",
+			policy:   Policy{MinLength: 8},
+			password: "기숙사비밀번호다",
+		},
+		{
+			name:     "missing required classes",
+			policy:   Policy{MinLength: 1, RequiredClasses: []string{ClassLower, ClassUpper, ClassDigit, ClassSymbol}},
+			password: "lowercase",
+			want:     []string{"missing_upper", "missing_digit", "missing_symbol"},
+		},
+		{
+			name:     "all required classes",
+			policy:   Policy{MinLength: 1, RequiredClasses: []string{ClassLower, ClassUpper, ClassDigit, ClassSymbol}},
+			password: "Dormi-2026",
+		},
+		{
+			name:     "space is not a symbol",
+			policy:   Policy{MinLength: 1, RequiredClasses: []string{ClassSymbol}},
+			password: "with space",
+			want:     []string{"missing_symbol"},
+		},
+		{
+			name:     "matches email",
+			policy:   Policy{MinLength: 1},
+			password: "Admin@Dormi.kr",
+			email:    "admin@dormi.kr",
+			want:     []string{"matches_email"},
+		},
+		{
+			name:     "matches email local part",
+			policy:   Policy{MinLength: 1},
+			password: "ADMIN.KIM",
+			email:    "admin.kim@dormi.kr",
+			want:     []string{"matches_email"},
+		},
+		{
+			name:     "contains email local part",
+			policy:   Policy{MinLength: 1},
+			password: "admin.kim-2026",
+			email:    "admin.kim@dormi.kr",
+		},
+		{
+			name:     "common password",
+			policy:   Policy{MinLength: 1},
+			password: "Password",
+			want:     []string{"too_common"},
+		},
+		{
+			name:     "reused password",
+			policy:   Policy{MinLength: 1, HistorySize: 3},
+			password: "Older#22",
+			history:  history,
+			want:     []string{"reused"},
+		},
+		{
+			name:     "history beyond the configured size is ignored",
+			policy:   Policy{MinLength: 1, HistorySize: 2},
+			password: "Oldest#333",
+			history:  history,
+		},
+		{
+			name:     "history disabled",
+			policy:   Policy{MinLength: 1},
+			password: "Previous#1",
+			history:  history,
+		},
+		{
+			name:     "reports every violation",
+			policy:   Policy{MinLength: 10, RequiredClasses: []string{ClassDigit}},
+			password: "qwerty",
+			want:     []string{"too_short", "missing_digit", "too_common"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate(tt.password, tt.email, tt.history)
+
+			var got []string
+			if err != nil {
+				var policyErr *PolicyError
+				if !errors.As(err, &policyErr) {
+					t.Fatalf("got %T, want *PolicyError", err)
+				}
+				for _, v := range policyErr.Violations {
+					if v.Message == "" {
+						t.Errorf("violation %s has no message", v.Code)
+					}
+					got = append(got, v.Code)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
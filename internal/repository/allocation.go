@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AllocationRepository struct {
+	db *gorm.DB
+}
+
+func NewAllocationRepository(db *gorm.DB) *AllocationRepository {
+	return &AllocationRepository{db: db}
+}
+
+func (r *AllocationRepository) Create(plan *model.AllocationPlan) error {
+	return r.db.Create(plan).Error
+}
+
+var allocationPlanPage = pagination.Spec[model.AllocationPlan]{
+	IDColumn:    "id",
+	ID:          func(p *model.AllocationPlan) uuid.UUID { return p.ID },
+	DefaultSort: "-createdAt",
+	Fields: map[string]pagination.Field[model.AllocationPlan]{
+		"createdAt": {Column: "created_at", Value: func(p *model.AllocationPlan) interface{} { return p.CreatedAt }},
+		"name":      {Column: "name", Value: func(p *model.AllocationPlan) interface{} { return p.Name }},
+	},
+}
+
+func (r *AllocationRepository) FindAll(query dto.PageQuery) ([]model.AllocationPlan, *dto.Pagination, error) {
+	plans, meta, err := pagination.Find(r.db.Model(&model.AllocationPlan{}), query, allocationPlanPage)
+	if err != nil || len(plans) == 0 {
+		return plans, meta, err
+	}
+
+	ids := make([]uuid.UUID, len(plans))
+	for i := range plans {
+		ids[i] = plans[i].ID
+	}
+
+	var rows []struct {
+		PlanID     uuid.UUID
+		Students   int
+		Unassigned int
+		Moved      int
+	}
+	err = r.db.Model(&model.AllocationItem{}).
+		Select(`allocation_items.plan_id,
+			COUNT(*) AS students,
+			SUM(CASE WHEN allocation_items.bed_id IS NULL THEN 1 ELSE 0 END) AS unassigned,
+			SUM(CASE WHEN students.id IS NOT NULL AND students.bed_id IS DISTINCT FROM allocation_items.bed_id THEN 1 ELSE 0 END) AS moved`).
+		Joins("LEFT JOIN students ON students.id = allocation_items.student_id AND students.deleted_at IS NULL").
+		Where("allocation_items.plan_id IN ?", ids).
+		Group("allocation_items.plan_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summaries := make(map[uuid.UUID]*model.AllocationSummary, len(rows))
+	for _, row := range rows {
+		summaries[row.PlanID] = &model.AllocationSummary{
+			Students:   row.Students,
+			Assigned:   row.Students - row.Unassigned,
+			Unassigned: row.Unassigned,
+			Moved:      row.Moved,
+		}
+	}
+	for i := range plans {
+		if summary, ok := summaries[plans[i].ID]; ok {
+			plans[i].Summary = summary
+		} else {
+			plans[i].Summary = &model.AllocationSummary{}
+		}
+	}
+
+	return plans, meta, nil
+}
+
+func (r *AllocationRepository) FindByID(id uuid.UUID) (*model.AllocationPlan, error) {
+	var plan model.AllocationPlan
+	err := r.db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Joins("JOIN students ON students.id = allocation_items.student_id").
+			Order("students.student_number")
+	}).Preload("Items.Student").Preload("Items.Bed.Room").First(&plan, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+func (r *AllocationRepository) UpdateItem(item *model.AllocationItem) error {
+	return r.db.Model(item).Updates(map[string]interface{}{
+		"bed_id": item.BedID,
+		"fixed":  item.Fixed,
+		"note":   item.Note,
+	}).Error
+}
+
+func (r *AllocationRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&model.AllocationPlan{}, "id = ?", id).Error
+}
+
+func (r *AllocationRepository) Commit(plan *model.AllocationPlan, reason string, at time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.AllocationPlan{}).
+			Where("id = ? AND status = ?", plan.ID, model.AllocationPlanStatusDraft).
+			Updates(map[string]interface{}{
+				"status":       model.AllocationPlanStatusCommitted,
+				"committed_at": at,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("allocation plan is not a draft")
+		}
+
+		targets := map[uuid.UUID]uuid.UUID{}
+		planned := map[uuid.UUID]uuid.UUID{}
+		var studentIDs, bedIDs []uuid.UUID
+		for _, item := range plan.Items {
+			if item.BedID == nil {
+				continue
+			}
+			if _, ok := planned[*item.BedID]; ok {
+				return errors.New("a bed is assigned twice in this plan")
+			}
+			targets[item.StudentID] = *item.BedID
+			planned[*item.BedID] = item.StudentID
+			studentIDs = append(studentIDs, item.StudentID)
+			bedIDs = append(bedIDs, *item.BedID)
+		}
+		if len(studentIDs) == 0 {
+			return errors.New("allocation plan has no assigned beds")
+		}
+
+		var roomIDs []uuid.UUID
+		if err := tx.Model(&model.Bed{}).Distinct("room_id").Where("id IN ?", bedIDs).Pluck("room_id", &roomIDs).Error; err != nil {
+			return err
+		}
+		var rooms []model.Room
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", roomIDs).Order("id").Find(&rooms).Error; err != nil {
+			return err
+		}
+		var beds []model.Bed
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("room_id IN ?", roomIDs).Order("id").Find(&beds).Error; err != nil {
+			return err
+		}
+
+		roomsByID := make(map[uuid.UUID]*model.Room, len(rooms))
+		for i := range rooms {
+			roomsByID[rooms[i].ID] = &rooms[i]
+		}
+		bedRooms := make(map[uuid.UUID]uuid.UUID, len(beds))
+		roomBedIDs := make([]uuid.UUID, 0, len(beds))
+		for _, bed := range beds {
+			bedRooms[bed.ID] = bed.RoomID
+			roomBedIDs = append(roomBedIDs, bed.ID)
+		}
+		for _, bedID := range bedIDs {
+			if _, ok := bedRooms[bedID]; !ok {
+				return errors.New("a planned bed no longer exists")
+			}
+		}
+
+		var students []model.Student
+		if err := tx.Where("id IN ?", studentIDs).Find(&students).Error; err != nil {
+			return err
+		}
+		if len(students) != len(studentIDs) {
+			return errors.New("a planned student no longer exists")
+		}
+
+		var occupants []model.Student
+		if err := tx.Where("bed_id IN ?", roomBedIDs).Find(&occupants).Error; err != nil {
+			return err
+		}
+
+		genders := map[uuid.UUID]map[model.Gender]bool{}
+		addGender := func(roomID uuid.UUID, gender model.Gender) {
+			if genders[roomID] == nil {
+				genders[roomID] = map[model.Gender]bool{}
+			}
+			genders[roomID][gender] = true
+		}
+		for _, occupant := range occupants {
+			if _, moving := targets[occupant.ID]; moving {
+				continue
+			}
+			if _, ok := planned[*occupant.BedID]; ok {
+				return errors.New("a planned bed is occupied by a student who is not moving")
+			}
+			addGender(bedRooms[*occupant.BedID], occupant.Gender)
+		}
+		for _, student := range students {
+			if student.Gender == "" {
+				return errors.New("student gender is not set")
+			}
+			addGender(bedRooms[targets[student.ID]], student.Gender)
+		}
+		for roomID, set := range genders {
+			room := roomsByID[roomID]
+			if len(set) > 1 || (room.Gender != "" && !set[room.Gender]) {
+				return errors.New("room " + room.Number + " would hold students of a different gender")
+			}
+		}
+
+		var moving []uuid.UUID
+		for _, student := range students {
+			bedID := targets[student.ID]
+			if !model.SameBed(student.BedID, &bedID) {
+				moving = append(moving, student.ID)
+			}
+		}
+		if len(moving) == 0 {
+			return nil
+		}
+		if err := tx.Model(&model.Student{}).Where("id IN ?", moving).Update("bed_id", nil).Error; err != nil {
+			return err
+		}
+
+		for i := range students {
+			student := &students[i]
+			bedID := targets[student.ID]
+			if model.SameBed(student.BedID, &bedID) {
+				continue
+			}
+
+			student.BedID = &bedID
+			student.RoomNumber = roomsByID[bedRooms[bedID]].Number
+			if err := tx.Model(student).Updates(map[string]interface{}{
+				"bed_id":      student.BedID,
+				"room_number": student.RoomNumber,
+			}).Error; err != nil {
+				return err
+			}
+			if err := closeAssignment(tx, student.ID, at); err != nil {
+				return err
+			}
+			if err := openAssignment(tx, student, reason, at); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
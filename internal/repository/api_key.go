@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+func (r *APIKeyRepository) Create(key *model.APIKey) error {
+	return r.db.Create(key).Error
+}
+
+func (r *APIKeyRepository) FindByID(id uuid.UUID) (*model.APIKey, error) {
+	var key model.APIKey
+	err := r.db.First(&key, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *APIKeyRepository) FindByHash(hash string) (*model.APIKey, error) {
+	var key model.APIKey
+	err := r.db.Preload("User").First(&key, "key_hash = ?", hash).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+var apiKeyPage = pagination.Spec[model.APIKey]{
+	IDColumn:    "id",
+	ID:          func(k *model.APIKey) uuid.UUID { return k.ID },
+	DefaultSort: "-createdAt",
+	Fields: map[string]pagination.Field[model.APIKey]{
+		"createdAt": {Column: "created_at", Value: func(k *model.APIKey) interface{} { return k.CreatedAt }},
+		"name":      {Column: "name", Value: func(k *model.APIKey) interface{} { return k.Name }},
+	},
+}
+
+func (r *APIKeyRepository) FindByUserID(userID uuid.UUID, query dto.PageQuery) ([]model.APIKey, *dto.Pagination, error) {
+	db := r.db.Model(&model.APIKey{}).Where("user_id = ?", userID)
+	return pagination.Find(db, query, apiKeyPage)
+}
+
+func (r *APIKeyRepository) UpdateLastUsedAt(id uuid.UUID, usedAt time.Time) error {
+	return r.db.Model(&model.APIKey{}).Where("id = ?", id).Update("last_used_at", usedAt).Error
+}
+
+func (r *APIKeyRepository) Revoke(id uuid.UUID) error {
+	return r.db.Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now()).Error
+}
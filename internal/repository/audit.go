@@ -3,6 +3,7 @@ package repository
 import (
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -20,15 +21,25 @@ func (r *AuditRepository) Create(log *model.AuditLog) error {
 	return r.db.Create(log).Error
 }
 
-func (r *AuditRepository) FindAll(query dto.AuditQuery) ([]model.AuditLog, int64, error) {
-	var logs []model.AuditLog
-	var total int64
+var auditLogPage = pagination.Spec[model.AuditLog]{
+	IDColumn:    "id",
+	ID:          func(l *model.AuditLog) uuid.UUID { return l.ID },
+	DefaultSort: "-createdAt",
+	Fields: map[string]pagination.Field[model.AuditLog]{
+		"createdAt": {Column: "created_at", Value: func(l *model.AuditLog) interface{} { return l.CreatedAt }},
+		"action":    {Column: "action", Value: func(l *model.AuditLog) interface{} { return l.Action }},
+	},
+}
 
-	db := r.db.Model(&model.AuditLog{}).Preload("User")
+func (r *AuditRepository) FindAll(query dto.AuditQuery) ([]model.AuditLog, *dto.Pagination, error) {
+	db := r.db.Model(&model.AuditLog{}).Preload("User").Preload("Impersonator")
 
 	if query.UserID != uuid.Nil {
 		db = db.Where("user_id = ?", query.UserID)
 	}
+	if query.ImpersonatorID != uuid.Nil {
+		db = db.Where("impersonator_id = ?", query.ImpersonatorID)
+	}
 	if query.Action != "" {
 		db = db.Where("action = ?", query.Action)
 	}
@@ -42,10 +53,5 @@ func (r *AuditRepository) FindAll(query dto.AuditQuery) ([]model.AuditLog, int64
 		db = db.Where("created_at <= ?", query.EndDate)
 	}
 
-	db.Count(&total)
-
-	offset := (query.Page - 1) * query.Limit
-	err := db.Offset(offset).Limit(query.Limit).Order("created_at DESC").Find(&logs).Error
-
-	return logs, total, err
+	return pagination.Find(db, query.PageQuery, auditLogPage)
 }
@@ -5,6 +5,7 @@ import (
 
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -35,9 +36,18 @@ func (r *DutyRepository) FindByID(id uuid.UUID) (*model.Duty, error) {
 	return &duty, nil
 }
 
-func (r *DutyRepository) FindAll(query dto.DutyQuery) ([]model.Duty, error) {
-	var duties []model.Duty
+var dutyPage = pagination.Spec[model.Duty]{
+	IDColumn:    "id",
+	ID:          func(d *model.Duty) uuid.UUID { return d.ID },
+	DefaultSort: "date",
+	Fields: map[string]pagination.Field[model.Duty]{
+		"date":      {Column: "date", Value: func(d *model.Duty) interface{} { return d.Date.Format("2006-01-02") }},
+		"type":      {Column: "type", Value: func(d *model.Duty) interface{} { return d.Type }},
+		"createdAt": {Column: "created_at", Value: func(d *model.Duty) interface{} { return d.CreatedAt }},
+	},
+}
 
+func (r *DutyRepository) FindAll(query dto.DutyQuery) ([]model.Duty, *dto.Pagination, error) {
 	db := r.db.Model(&model.Duty{}).Preload("Assignee")
 
 	if query.Type != "" {
@@ -55,8 +65,16 @@ func (r *DutyRepository) FindAll(query dto.DutyQuery) ([]model.Duty, error) {
 		db = db.Where("date <= ?", endDate)
 	}
 
-	err := db.Order("date").Find(&duties).Error
+	return pagination.Find(db, query.PageQuery, dutyPage)
+}
 
+func (r *DutyRepository) FindUpcomingByAssignee(assigneeID uuid.UUID, from time.Time, limit int) ([]model.Duty, error) {
+	var duties []model.Duty
+	err := r.db.
+		Where("assignee_id = ? AND date >= ?", assigneeID, from).
+		Order("date").
+		Limit(limit).
+		Find(&duties).Error
 	return duties, err
 }
 
@@ -103,22 +121,48 @@ func (r *DutySwapRequestRepository) FindByID(id uuid.UUID) (*model.DutySwapReque
 	return &req, nil
 }
 
+var swapRequestPage = pagination.Spec[model.DutySwapRequest]{
+	IDColumn:    "duty_swap_requests.id",
+	ID:          func(s *model.DutySwapRequest) uuid.UUID { return s.ID },
+	DefaultSort: "-createdAt",
+	Fields: map[string]pagination.Field[model.DutySwapRequest]{
+		"createdAt": {Column: "duty_swap_requests.created_at", Value: func(s *model.DutySwapRequest) interface{} { return s.CreatedAt }},
+	},
+}
+
 func (r *DutySwapRequestRepository) FindPendingByTargetAssignee(assigneeID uuid.UUID) ([]model.DutySwapRequest, error) {
 	var requests []model.DutySwapRequest
-	err := r.db.
+	err := r.pendingByTargetAssignee(assigneeID).
+		Order("duty_swap_requests.created_at DESC").
+		Find(&requests).Error
+	return requests, err
+}
+
+func (r *DutySwapRequestRepository) FindPendingPageByTargetAssignee(assigneeID uuid.UUID, query dto.PageQuery) ([]model.DutySwapRequest, *dto.Pagination, error) {
+	return pagination.Find(r.pendingByTargetAssignee(assigneeID), query, swapRequestPage)
+}
+
+func (r *DutySwapRequestRepository) pendingByTargetAssignee(assigneeID uuid.UUID) *gorm.DB {
+	return r.preload(r.db.Model(&model.DutySwapRequest{})).
+		Joins("JOIN duties ON duties.id = duty_swap_requests.target_duty_id").
+		Where("duties.assignee_id = ? AND duty_swap_requests.status = ?", assigneeID, model.DutySwapStatusPending)
+}
+
+func (r *DutySwapRequestRepository) FindByRequester(requesterID uuid.UUID, query dto.PageQuery) ([]model.DutySwapRequest, *dto.Pagination, error) {
+	db := r.preload(r.db.Model(&model.DutySwapRequest{})).Where("requester_id = ?", requesterID)
+	return pagination.Find(db, query, swapRequestPage)
+}
+
+func (r *DutySwapRequestRepository) preload(db *gorm.DB) *gorm.DB {
+	return db.
 		Preload("Requester").
 		Preload("SourceDuty").
 		Preload("SourceDuty.Assignee").
 		Preload("TargetDuty").
-		Preload("TargetDuty.Assignee").
-		Joins("JOIN duties ON duties.id = duty_swap_requests.target_duty_id").
-		Where("duties.assignee_id = ? AND duty_swap_requests.status = ?", assigneeID, model.DutySwapStatusPending).
-		Order("duty_swap_requests.created_at DESC").
-		Find(&requests).Error
-	return requests, err
+		Preload("TargetDuty.Assignee")
 }
 
-func (r *DutySwapRequestRepository) FindByRequester(requesterID uuid.UUID) ([]model.DutySwapRequest, error) {
+func (r *DutySwapRequestRepository) FindPendingByRequester(requesterID uuid.UUID) ([]model.DutySwapRequest, error) {
 	var requests []model.DutySwapRequest
 	err := r.db.
 		Preload("Requester").
@@ -126,7 +170,7 @@ func (r *DutySwapRequestRepository) FindByRequester(requesterID uuid.UUID) ([]mo
 		Preload("SourceDuty.Assignee").
 		Preload("TargetDuty").
 		Preload("TargetDuty.Assignee").
-		Where("requester_id = ?", requesterID).
+		Where("requester_id = ? AND status = ?", requesterID, model.DutySwapStatusPending).
 		Order("created_at DESC").
 		Find(&requests).Error
 	return requests, err
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"time"
+
+	"dormi-api/internal/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type EmailChangeTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailChangeTokenRepository(db *gorm.DB) *EmailChangeTokenRepository {
+	return &EmailChangeTokenRepository{db: db}
+}
+
+func (r *EmailChangeTokenRepository) Create(token *model.EmailChangeToken) error {
+	return r.db.Create(token).Error
+}
+
+func (r *EmailChangeTokenRepository) FindByHash(tokenHash string) (*model.EmailChangeToken, error) {
+	var token model.EmailChangeToken
+	err := r.db.Preload("User").First(&token, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *EmailChangeTokenRepository) MarkUsed(id uuid.UUID) (bool, error) {
+	result := r.db.Model(&model.EmailChangeToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *EmailChangeTokenRepository) InvalidateByUserID(userID uuid.UUID) error {
+	return r.db.Model(&model.EmailChangeToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now()).Error
+}
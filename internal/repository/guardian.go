@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"dormi-api/internal/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type GuardianRepository struct {
+	db *gorm.DB
+}
+
+func NewGuardianRepository(db *gorm.DB) *GuardianRepository {
+	return &GuardianRepository{db: db}
+}
+
+func (r *GuardianRepository) Save(guardian *model.Guardian) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if guardian.IsPrimary {
+			if err := tx.Model(&model.Guardian{}).
+				Where("student_id = ? AND id <> ?", guardian.StudentID, guardian.ID).
+				Update("is_primary", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Save(guardian).Error
+	})
+}
+
+func (r *GuardianRepository) FindByID(id uuid.UUID) (*model.Guardian, error) {
+	var guardian model.Guardian
+	err := r.db.First(&guardian, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &guardian, nil
+}
+
+func (r *GuardianRepository) FindByStudentID(studentID uuid.UUID) ([]model.Guardian, error) {
+	var guardians []model.Guardian
+	err := r.db.Where("student_id = ?", studentID).Order("is_primary DESC, created_at").Find(&guardians).Error
+	return guardians, err
+}
+
+func (r *GuardianRepository) CountByStudentID(studentID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.Guardian{}).Where("student_id = ?", studentID).Count(&count).Error
+	return count, err
+}
+
+func (r *GuardianRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&model.Guardian{}, "id = ?", id).Error
+}
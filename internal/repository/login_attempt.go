@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"time"
+
+	"dormi-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type LoginAttemptRepository struct {
+	db *gorm.DB
+}
+
+func NewLoginAttemptRepository(db *gorm.DB) *LoginAttemptRepository {
+	return &LoginAttemptRepository{db: db}
+}
+
+func (r *LoginAttemptRepository) FindByKey(key string) (*model.LoginAttempt, error) {
+	var attempt model.LoginAttempt
+	err := r.db.First(&attempt, "key = ?", key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+func (r *LoginAttemptRepository) IncrementFailures(key string, now, resetBefore time.Time) (*model.LoginAttempt, error) {
+	var attempt model.LoginAttempt
+	err := r.db.Raw(`
+		INSERT INTO login_attempts (key, failures, lockouts, last_failure_at, updated_at)
+		VALUES (?, 1, 0, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN login_attempts.last_failure_at < ? THEN 1 ELSE login_attempts.failures + 1 END,
+			lockouts = CASE WHEN login_attempts.last_failure_at < ? THEN 0 ELSE login_attempts.lockouts END,
+			last_failure_at = EXCLUDED.last_failure_at,
+			updated_at = EXCLUDED.updated_at
+		RETURNING *`,
+		key, now, now, resetBefore, resetBefore,
+	).Scan(&attempt).Error
+	if err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+func (r *LoginAttemptRepository) Lock(key string, maxFailures int, until time.Time) (bool, error) {
+	result := r.db.Model(&model.LoginAttempt{}).
+		Where("key = ? AND failures >= ?", key, maxFailures).
+		Updates(map[string]interface{}{
+			"locked_until": until,
+			"failures":     0,
+			"lockouts":     gorm.Expr("lockouts + 1"),
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *LoginAttemptRepository) DecrementFailures(key string) error {
+	return r.db.Model(&model.LoginAttempt{}).
+		Where("key = ? AND failures > 0", key).
+		Update("failures", gorm.Expr("failures - 1")).Error
+}
+
+func (r *LoginAttemptRepository) Delete(key string) error {
+	return r.db.Delete(&model.LoginAttempt{}, "key = ?", key).Error
+}
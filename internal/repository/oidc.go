@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"time"
+
+	"dormi-api/internal/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OIDCAuthRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewOIDCAuthRequestRepository(db *gorm.DB) *OIDCAuthRequestRepository {
+	return &OIDCAuthRequestRepository{db: db}
+}
+
+func (r *OIDCAuthRequestRepository) Create(req *model.OIDCAuthRequest) error {
+	return r.db.Create(req).Error
+}
+
+func (r *OIDCAuthRequestRepository) FindByStateHash(hash string) (*model.OIDCAuthRequest, error) {
+	var req model.OIDCAuthRequest
+	err := r.db.First(&req, "state_hash = ?", hash).Error
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (r *OIDCAuthRequestRepository) MarkUsed(id uuid.UUID) (bool, error) {
+	result := r.db.Model(&model.OIDCAuthRequest{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *OIDCAuthRequestRepository) DeleteExpired(before time.Time) error {
+	return r.db.Where("expires_at < ?", before).Delete(&model.OIDCAuthRequest{}).Error
+}
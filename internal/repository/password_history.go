@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"dormi-api/internal/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PasswordHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordHistoryRepository(db *gorm.DB) *PasswordHistoryRepository {
+	return &PasswordHistoryRepository{db: db}
+}
+
+func (r *PasswordHistoryRepository) FindRecent(userID uuid.UUID, limit int) ([]model.PasswordHistory, error) {
+	var histories []model.PasswordHistory
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Limit(limit).Find(&histories).Error
+	return histories, err
+}
+
+func (r *PasswordHistoryRepository) Add(history *model.PasswordHistory, keep int) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(history).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND id NOT IN (?)", history.UserID,
+			tx.Model(&model.PasswordHistory{}).Select("id").Where("user_id = ?", history.UserID).Order("created_at DESC").Limit(keep),
+		).Delete(&model.PasswordHistory{}).Error
+	})
+}
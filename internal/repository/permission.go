@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"dormi-api/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PermissionRepository struct {
+	db *gorm.DB
+}
+
+func NewPermissionRepository(db *gorm.DB) *PermissionRepository {
+	return &PermissionRepository{db: db}
+}
+
+func (r *PermissionRepository) FindAll() ([]model.RolePermission, error) {
+	var grants []model.RolePermission
+	err := r.db.Order("role, permission").Find(&grants).Error
+	return grants, err
+}
+
+func (r *PermissionRepository) FindSeeded() ([]model.SeededPermission, error) {
+	var seeded []model.SeededPermission
+	err := r.db.Find(&seeded).Error
+	return seeded, err
+}
+
+func (r *PermissionRepository) Seed(permission model.Permission, roles []model.Role) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, role := range roles {
+			grant := model.RolePermission{Role: role, Permission: permission}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&grant).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.SeededPermission{Permission: permission}).Error
+	})
+}
+
+func (r *PermissionRepository) ReplaceForRole(role model.Role, permissions []model.Permission) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("role = ?", role).Delete(&model.RolePermission{}).Error; err != nil {
+			return err
+		}
+		if len(permissions) == 0 {
+			return nil
+		}
+		grants := make([]model.RolePermission, 0, len(permissions))
+		for _, p := range permissions {
+			grants = append(grants, model.RolePermission{Role: role, Permission: p})
+		}
+		return tx.Create(&grants).Error
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -28,18 +29,30 @@ func (r *PointRepository) CreateBatch(points []model.Point) error {
 
 func (r *PointRepository) FindByID(id uuid.UUID) (*model.Point, error) {
 	var point model.Point
-	err := r.db.Preload("Student").Preload("Reason").Preload("GivenByUser").First(&point, "id = ?", id).Error
+	err := r.db.Preload("Student").Preload("Reason").Preload("GivenByUser").Preload("ProposedByUser").First(&point, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &point, nil
 }
 
-func (r *PointRepository) FindAll(query dto.PointQuery) ([]model.Point, error) {
-	var points []model.Point
+var pointPage = pagination.Spec[model.Point]{
+	IDColumn:    "points.id",
+	ID:          func(p *model.Point) uuid.UUID { return p.ID },
+	DefaultSort: "-givenAt",
+	Fields: map[string]pagination.Field[model.Point]{
+		"givenAt": {Column: "points.given_at", Value: func(p *model.Point) interface{} { return p.GivenAt }},
+	},
+}
 
-	db := r.db.Model(&model.Point{}).Preload("Student").Preload("Reason").Preload("GivenByUser")
+func (r *PointRepository) FindAll(query dto.PointQuery) ([]model.Point, *dto.Pagination, error) {
+	db := r.db.Model(&model.Point{}).Preload("Student", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}).Preload("Reason").Preload("GivenByUser").Preload("ProposedByUser")
 
+	if !query.IncludeArchived {
+		db = db.Where("points.archived_at IS NULL")
+	}
 	if query.StudentID != uuid.Nil {
 		db = db.Where("student_id = ?", query.StudentID)
 	}
@@ -54,18 +67,13 @@ func (r *PointRepository) FindAll(query dto.PointQuery) ([]model.Point, error) {
 		db = db.Where("given_at <= ?", query.EndDate)
 	}
 
-	err := db.Order("given_at DESC").Find(&points).Error
-
-	return points, err
+	return pagination.Find(db, query.PageQuery, pointPage)
 }
 
-func (r *PointRepository) FindByStudentID(studentID uuid.UUID) ([]model.Point, error) {
-	var points []model.Point
-	err := r.db.Preload("Reason").Preload("GivenByUser").
-		Where("student_id = ? AND cancelled = false", studentID).
-		Order("given_at DESC").
-		Find(&points).Error
-	return points, err
+func (r *PointRepository) FindByStudentID(studentID uuid.UUID, page dto.PageQuery) ([]model.Point, *dto.Pagination, error) {
+	db := r.db.Model(&model.Point{}).Preload("Reason").Preload("GivenByUser").Preload("ProposedByUser").
+		Where("student_id = ? AND cancelled = false AND archived_at IS NULL", studentID)
+	return pagination.Find(db, page, pointPage)
 }
 
 func (r *PointRepository) GetSummary(studentID uuid.UUID) (*dto.PointSummary, error) {
@@ -75,7 +83,7 @@ func (r *PointRepository) GetSummary(studentID uuid.UUID) (*dto.PointSummary, er
 	err := r.db.Model(&model.Point{}).
 		Joins("JOIN point_reasons ON point_reasons.id = points.reason_id").
 		Select("COALESCE(SUM(CASE WHEN point_reasons.type = 'REWARD' THEN point_reasons.score ELSE 0 END), 0) as total_reward, COALESCE(SUM(CASE WHEN point_reasons.type = 'PENALTY' THEN point_reasons.score ELSE 0 END), 0) as total_penalty").
-		Where("points.student_id = ? AND points.cancelled = false", studentID).
+		Where("points.student_id = ? AND points.cancelled = false AND points.archived_at IS NULL", studentID).
 		Row().Scan(&result.TotalReward, &result.TotalPenalty)
 
 	result.NetScore = result.TotalReward - result.TotalPenalty
@@ -93,6 +101,17 @@ func (r *PointRepository) Cancel(id uuid.UUID, cancelledBy uuid.UUID) error {
 		}).Error
 }
 
+func (r *PointRepository) CountUnarchived(studentIDs []uuid.UUID) (int64, error) {
+	var count int64
+	if len(studentIDs) == 0 {
+		return 0, nil
+	}
+	err := r.db.Model(&model.Point{}).
+		Where("student_id IN ? AND archived_at IS NULL", studentIDs).
+		Count(&count).Error
+	return count, err
+}
+
 func (r *PointRepository) ResetAll() error {
-	return r.db.Where("1 = 1").Delete(&model.Point{}).Error
+	return r.db.Where("archived_at IS NULL").Delete(&model.Point{}).Error
 }
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PointProposalRepository struct {
+	db *gorm.DB
+}
+
+func NewPointProposalRepository(db *gorm.DB) *PointProposalRepository {
+	return &PointProposalRepository{db: db}
+}
+
+func (r *PointProposalRepository) CreateWithinDailyLimit(proposal *model.PointProposal, startOfDay time.Time, dailyLimit int) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var proposer model.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&proposer, "id = ?", proposal.ProposedBy).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		err := tx.Model(&model.PointProposal{}).
+			Where("proposed_by = ? AND created_at >= ?", proposal.ProposedBy, startOfDay).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if int(count) >= dailyLimit {
+			return errors.New("daily proposal limit reached")
+		}
+
+		return tx.Create(proposal).Error
+	})
+}
+
+func (r *PointProposalRepository) FindByID(id uuid.UUID) (*model.PointProposal, error) {
+	var proposal model.PointProposal
+	err := r.db.
+		Preload("Student").
+		Preload("Reason").
+		Preload("ProposedByUser").
+		Preload("ReviewedByUser").
+		First(&proposal, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+var pointProposalPage = pagination.Spec[model.PointProposal]{
+	IDColumn:    "id",
+	ID:          func(p *model.PointProposal) uuid.UUID { return p.ID },
+	DefaultSort: "-createdAt",
+	Fields: map[string]pagination.Field[model.PointProposal]{
+		"createdAt": {Column: "created_at", Value: func(p *model.PointProposal) interface{} { return p.CreatedAt }},
+		"status":    {Column: "status", Value: func(p *model.PointProposal) interface{} { return p.Status }},
+	},
+}
+
+func (r *PointProposalRepository) FindAll(query dto.PointProposalQuery) ([]model.PointProposal, *dto.Pagination, error) {
+	db := r.db.Model(&model.PointProposal{}).
+		Preload("Student").
+		Preload("Reason").
+		Preload("ProposedByUser").
+		Preload("ReviewedByUser")
+
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.StudentID != uuid.Nil {
+		db = db.Where("student_id = ?", query.StudentID)
+	}
+	if query.ProposedBy != uuid.Nil {
+		db = db.Where("proposed_by = ?", query.ProposedBy)
+	}
+
+	return pagination.Find(db, query.PageQuery, pointProposalPage)
+}
+
+func (r *PointProposalRepository) Approve(proposal *model.PointProposal, point *model.Point, dailyLimit int) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var proposer model.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&proposer, "id = ?", proposal.ProposedBy).Error; err != nil {
+			return err
+		}
+
+		var reason model.PointReason
+		if err := tx.First(&reason, "id = ?", proposal.ReasonID).Error; err != nil {
+			return errors.New("invalid reason")
+		}
+		if !reason.CouncilAllowed {
+			return errors.New("this reason cannot be proposed by council members")
+		}
+
+		created := proposal.CreatedAt.Local()
+		startOfDay := time.Date(created.Year(), created.Month(), created.Day(), 0, 0, 0, 0, created.Location())
+		var approved int64
+		err := tx.Model(&model.PointProposal{}).
+			Where("proposed_by = ? AND status = ? AND created_at >= ? AND created_at < ?",
+				proposal.ProposedBy, model.PointProposalStatusApproved, startOfDay, startOfDay.AddDate(0, 0, 1)).
+			Count(&approved).Error
+		if err != nil {
+			return err
+		}
+		if int(approved) >= dailyLimit {
+			return errors.New("daily proposal limit reached")
+		}
+
+		if err := tx.Create(point).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		result := tx.Model(&model.PointProposal{}).
+			Where("id = ? AND status = ?", proposal.ID, model.PointProposalStatusPending).
+			Updates(map[string]interface{}{
+				"status":      model.PointProposalStatusApproved,
+				"reviewed_by": point.GivenBy,
+				"reviewed_at": now,
+				"point_id":    point.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("proposal is not pending")
+		}
+		return nil
+	})
+}
+
+func (r *PointProposalRepository) Reject(id, reviewedBy uuid.UUID, comment string) error {
+	result := r.db.Model(&model.PointProposal{}).
+		Where("id = ? AND status = ?", id, model.PointProposalStatusPending).
+		Updates(map[string]interface{}{
+			"status":         model.PointProposalStatusRejected,
+			"reviewed_by":    reviewedBy,
+			"reviewed_at":    time.Now(),
+			"review_comment": comment,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("proposal is not pending")
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -28,16 +30,24 @@ func (r *PointReasonRepository) FindByID(id uuid.UUID) (*model.PointReason, erro
 	return &reason, nil
 }
 
-func (r *PointReasonRepository) FindAll() ([]model.PointReason, error) {
-	var reasons []model.PointReason
-	err := r.db.Order("type, name").Find(&reasons).Error
-	return reasons, err
+var pointReasonPage = pagination.Spec[model.PointReason]{
+	IDColumn:    "id",
+	ID:          func(p *model.PointReason) uuid.UUID { return p.ID },
+	DefaultSort: "name",
+	Fields: map[string]pagination.Field[model.PointReason]{
+		"name":      {Column: "name", Value: func(p *model.PointReason) interface{} { return p.Name }},
+		"type":      {Column: "type", Value: func(p *model.PointReason) interface{} { return p.Type }},
+		"score":     {Column: "score", Value: func(p *model.PointReason) interface{} { return p.Score }},
+		"createdAt": {Column: "created_at", Value: func(p *model.PointReason) interface{} { return p.CreatedAt }},
+	},
 }
 
-func (r *PointReasonRepository) FindByType(pointType model.PointType) ([]model.PointReason, error) {
-	var reasons []model.PointReason
-	err := r.db.Where("type = ?", pointType).Order("name").Find(&reasons).Error
-	return reasons, err
+func (r *PointReasonRepository) FindAll(query dto.PointReasonQuery) ([]model.PointReason, *dto.Pagination, error) {
+	db := r.db.Model(&model.PointReason{})
+	if query.Type != "" {
+		db = db.Where("type = ?", query.Type)
+	}
+	return pagination.Find(db, query.PageQuery, pointReasonPage)
 }
 
 func (r *PointReasonRepository) Update(reason *model.PointReason) error {
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"errors"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BuildingRepository struct {
+	db *gorm.DB
+}
+
+func NewBuildingRepository(db *gorm.DB) *BuildingRepository {
+	return &BuildingRepository{db: db}
+}
+
+func (r *BuildingRepository) Create(building *model.Building) error {
+	return r.db.Create(building).Error
+}
+
+var buildingPage = pagination.Spec[model.Building]{
+	IDColumn:    "id",
+	ID:          func(b *model.Building) uuid.UUID { return b.ID },
+	DefaultSort: "name",
+	Fields: map[string]pagination.Field[model.Building]{
+		"name":      {Column: "name", Value: func(b *model.Building) interface{} { return b.Name }},
+		"createdAt": {Column: "created_at", Value: func(b *model.Building) interface{} { return b.CreatedAt }},
+	},
+}
+
+func (r *BuildingRepository) FindAll() ([]model.Building, error) {
+	var buildings []model.Building
+	err := r.preload(r.db).Order("name").Find(&buildings).Error
+	return buildings, err
+}
+
+func (r *BuildingRepository) FindPage(query dto.PageQuery) ([]model.Building, *dto.Pagination, error) {
+	return pagination.Find(r.preload(r.db.Model(&model.Building{})), query, buildingPage)
+}
+
+func (r *BuildingRepository) preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Floors", func(db *gorm.DB) *gorm.DB {
+		return db.Order("number")
+	})
+}
+
+func (r *BuildingRepository) FindByName(name string) (*model.Building, error) {
+	var building model.Building
+	err := r.db.First(&building, "name = ?", name).Error
+	if err != nil {
+		return nil, err
+	}
+	return &building, nil
+}
+
+func (r *BuildingRepository) FindByID(id uuid.UUID) (*model.Building, error) {
+	var building model.Building
+	err := r.preload(r.db).First(&building, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &building, nil
+}
+
+func (r *BuildingRepository) Update(building *model.Building) error {
+	return r.db.Omit("Floors").Save(building).Error
+}
+
+func (r *BuildingRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&model.Building{}, "id = ?", id).Error
+}
+
+func (r *BuildingRepository) CreateFloor(floor *model.Floor) error {
+	return r.db.Create(floor).Error
+}
+
+func (r *BuildingRepository) FindFloorByID(id uuid.UUID) (*model.Floor, error) {
+	var floor model.Floor
+	err := r.db.Preload("Building").First(&floor, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &floor, nil
+}
+
+func (r *BuildingRepository) DeleteFloor(id uuid.UUID) error {
+	return r.db.Delete(&model.Floor{}, "id = ?", id).Error
+}
+
+func (r *BuildingRepository) FindFloorsByNumber(number int) ([]model.Floor, error) {
+	var floors []model.Floor
+	err := r.db.Where("number = ?", number).Find(&floors).Error
+	return floors, err
+}
+
+func (r *BuildingRepository) CountFloors(buildingID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.Floor{}).Where("building_id = ?", buildingID).Count(&count).Error
+	return count, err
+}
+
+func (r *BuildingRepository) CountRooms(floorID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.Room{}).Where("floor_id = ?", floorID).Count(&count).Error
+	return count, err
+}
+
+type RoomRepository struct {
+	db *gorm.DB
+}
+
+func NewRoomRepository(db *gorm.DB) *RoomRepository {
+	return &RoomRepository{db: db}
+}
+
+func (r *RoomRepository) Create(room *model.Room, buildingID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := reserveRoomNumber(tx, room, buildingID); err != nil {
+			return err
+		}
+		return tx.Create(room).Error
+	})
+}
+
+func (r *RoomRepository) FindByID(id uuid.UUID) (*model.Room, error) {
+	var room model.Room
+	err := r.preload(r.db).First(&room, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+func (r *RoomRepository) FindByNumber(number string, buildingID uuid.UUID) ([]model.Room, error) {
+	var rooms []model.Room
+	db := r.preload(r.db.Model(&model.Room{})).Where("rooms.number = ?", number)
+	if buildingID != uuid.Nil {
+		db = db.Joins("JOIN floors ON floors.id = rooms.floor_id").Where("floors.building_id = ?", buildingID)
+	}
+	err := db.Find(&rooms).Error
+	return rooms, err
+}
+
+var roomPage = pagination.Spec[model.Room]{
+	IDColumn:    "rooms.id",
+	ID:          func(r *model.Room) uuid.UUID { return r.ID },
+	DefaultSort: "number",
+	Fields: map[string]pagination.Field[model.Room]{
+		"number":    {Column: "rooms.number", Value: func(r *model.Room) interface{} { return r.Number }},
+		"capacity":  {Column: "rooms.capacity", Value: func(r *model.Room) interface{} { return r.Capacity }},
+		"createdAt": {Column: "rooms.created_at", Value: func(r *model.Room) interface{} { return r.CreatedAt }},
+	},
+}
+
+func (r *RoomRepository) FindAll(query dto.RoomQuery) ([]model.Room, error) {
+	var rooms []model.Room
+	err := r.filter(query).Order("rooms.number").Find(&rooms).Error
+	return rooms, err
+}
+
+func (r *RoomRepository) FindPage(query dto.RoomQuery, page dto.PageQuery) ([]model.Room, *dto.Pagination, error) {
+	return pagination.Find(r.filter(query), page, roomPage)
+}
+
+func (r *RoomRepository) filter(query dto.RoomQuery) *gorm.DB {
+	db := r.preload(r.db.Model(&model.Room{}))
+
+	if query.BuildingID != uuid.Nil || query.Floor != nil {
+		db = db.Joins("JOIN floors ON floors.id = rooms.floor_id")
+		if query.BuildingID != uuid.Nil {
+			db = db.Where("floors.building_id = ?", query.BuildingID)
+		}
+		if query.Floor != nil {
+			db = db.Where("floors.number = ?", *query.Floor)
+		}
+	}
+	if query.Gender != "" {
+		db = db.Where("rooms.gender = ?", query.Gender)
+	}
+	if query.VacantOnly {
+		occupied := r.db.Model(&model.Student{}).Select("bed_id").Where("bed_id IS NOT NULL")
+		vacantBeds := r.db.Model(&model.Bed{}).Select("room_id").Where("id NOT IN (?)", occupied)
+		db = db.Where("rooms.id IN (?)", vacantBeds)
+	}
+
+	return db
+}
+
+func (r *RoomRepository) Update(room *model.Room, buildingID uuid.UUID, addBeds []model.Bed, removeBedIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := reserveRoomNumber(tx, room, buildingID); err != nil {
+			return err
+		}
+		if len(removeBedIDs) > 0 {
+			if err := tx.Delete(&model.Bed{}, "id IN ?", removeBedIDs).Error; err != nil {
+				return err
+			}
+		}
+		if len(addBeds) > 0 {
+			if err := tx.Create(&addBeds).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("Floor", "Beds").Save(room).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Student{}).
+			Where("bed_id IN (?)", tx.Model(&model.Bed{}).Select("id").Where("room_id = ?", room.ID)).
+			Update("room_number", room.Number).Error
+	})
+}
+
+func reserveRoomNumber(tx *gorm.DB, room *model.Room, buildingID uuid.UUID) error {
+	var building model.Building
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&building, "id = ?", buildingID).Error; err != nil {
+		return err
+	}
+
+	var count int64
+	err := tx.Model(&model.Room{}).
+		Joins("JOIN floors ON floors.id = rooms.floor_id").
+		Where("floors.building_id = ? AND rooms.number = ? AND rooms.id <> ?", buildingID, room.Number, room.ID).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("room number already exists in this building")
+	}
+	return nil
+}
+
+func (r *RoomRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&model.Room{}, "id = ?", id).Error
+}
+
+func (r *RoomRepository) FindBedByID(id uuid.UUID) (*model.Bed, error) {
+	var bed model.Bed
+	err := r.db.Preload("Room").First(&bed, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &bed, nil
+}
+
+func (r *RoomRepository) FindOccupants(bedIDs []uuid.UUID) ([]model.Student, error) {
+	var students []model.Student
+	if len(bedIDs) == 0 {
+		return students, nil
+	}
+	err := r.db.Where("bed_id IN ?", bedIDs).Find(&students).Error
+	return students, err
+}
+
+func (r *RoomRepository) preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Floor.Building").Preload("Beds", func(db *gorm.DB) *gorm.DB {
+		return db.Order("LENGTH(label), label")
+	})
+}
+
+func (r *RoomRepository) CountOccupantsByRoom() (map[uuid.UUID]int, error) {
+	var rows []struct {
+		RoomID uuid.UUID
+		Count  int
+	}
+	err := r.db.Model(&model.Student{}).
+		Select("beds.room_id, COUNT(*) AS count").
+		Joins("JOIN beds ON beds.id = students.bed_id").
+		Group("beds.room_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.RoomID] = row.Count
+	}
+	return counts, nil
+}
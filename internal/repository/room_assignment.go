@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RoomAssignmentRepository struct {
+	db *gorm.DB
+}
+
+func NewRoomAssignmentRepository(db *gorm.DB) *RoomAssignmentRepository {
+	return &RoomAssignmentRepository{db: db}
+}
+
+var roomAssignmentPage = pagination.Spec[model.RoomAssignment]{
+	IDColumn:    "id",
+	ID:          func(a *model.RoomAssignment) uuid.UUID { return a.ID },
+	DefaultSort: "-startedAt",
+	Fields: map[string]pagination.Field[model.RoomAssignment]{
+		"startedAt": {Column: "started_at", Value: func(a *model.RoomAssignment) interface{} { return a.StartedAt }},
+		"endedAt": {Column: "COALESCE(ended_at, 'infinity')", Value: func(a *model.RoomAssignment) interface{} {
+			if a.EndedAt == nil {
+				return "infinity"
+			}
+			return *a.EndedAt
+		}},
+	},
+}
+
+func (r *RoomAssignmentRepository) FindByStudentID(studentID uuid.UUID, page dto.PageQuery) ([]model.RoomAssignment, *dto.Pagination, error) {
+	db := r.db.Model(&model.RoomAssignment{}).Where("student_id = ?", studentID)
+	return pagination.Find(db, page, roomAssignmentPage)
+}
+
+func (r *RoomAssignmentRepository) FindByRoom(roomNumber string, buildingID uuid.UUID, from, to time.Time) ([]model.RoomAssignment, error) {
+	var assignments []model.RoomAssignment
+
+	rooms := r.db.Model(&model.Room{}).Select("rooms.id").Where("rooms.number = ?", roomNumber)
+	db := r.db.Preload("Student", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	})
+	if buildingID != uuid.Nil {
+		rooms = rooms.Joins("JOIN floors ON floors.id = rooms.floor_id").Where("floors.building_id = ?", buildingID)
+		db = db.Where("room_id IN (?)", rooms)
+	} else {
+		db = db.Where("room_number = ? OR room_id IN (?)", roomNumber, rooms)
+	}
+
+	err := db.Where("started_at < ? AND (ended_at IS NULL OR ended_at > ?)", to, from).
+		Order("started_at").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+func closeAssignment(tx *gorm.DB, studentID uuid.UUID, at time.Time) error {
+	return tx.Model(&model.RoomAssignment{}).
+		Where("student_id = ? AND ended_at IS NULL", studentID).
+		Update("ended_at", at).Error
+}
+
+func openAssignment(tx *gorm.DB, student *model.Student, reason string, at time.Time) error {
+	if student.BedID == nil {
+		return nil
+	}
+
+	var bed model.Bed
+	if err := tx.First(&bed, "id = ?", *student.BedID).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(&model.RoomAssignment{
+		StudentID:     &student.ID,
+		StudentNumber: student.StudentNumber,
+		StudentName:   student.Name,
+		RoomID:        &bed.RoomID,
+		BedID:         &bed.ID,
+		RoomNumber:    student.RoomNumber,
+		BedLabel:      bed.Label,
+		StartedAt:     at,
+		Reason:        reason,
+	}).Error
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SessionRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func (r *SessionRepository) Create(session *model.Session) error {
+	return r.db.Create(session).Error
+}
+
+func (r *SessionRepository) FindByID(id uuid.UUID) (*model.Session, error) {
+	var session model.Session
+	err := r.db.Preload("User").Preload("Impersonator").First(&session, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+var sessionPage = pagination.Spec[model.Session]{
+	IDColumn:    "id",
+	ID:          func(s *model.Session) uuid.UUID { return s.ID },
+	DefaultSort: "-lastSeenAt",
+	Fields: map[string]pagination.Field[model.Session]{
+		"lastSeenAt": {Column: "last_seen_at", Value: func(s *model.Session) interface{} { return s.LastSeenAt }},
+		"createdAt":  {Column: "created_at", Value: func(s *model.Session) interface{} { return s.CreatedAt }},
+	},
+}
+
+func (r *SessionRepository) FindActiveByUserID(userID uuid.UUID, now time.Time, query dto.PageQuery) ([]model.Session, *dto.Pagination, error) {
+	db := r.db.Model(&model.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now)
+	return pagination.Find(db, query, sessionPage)
+}
+
+func (r *SessionRepository) Touch(id uuid.UUID, ipAddress string, seenAt time.Time) error {
+	return r.db.Model(&model.Session{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"ip_address":   ipAddress,
+		"last_seen_at": seenAt,
+	}).Error
+}
+
+func (r *SessionRepository) UpdateExpiresAt(id uuid.UUID, expiresAt time.Time) error {
+	return r.db.Model(&model.Session{}).Where("id = ?", id).Update("expires_at", expiresAt).Error
+}
+
+func (r *SessionRepository) Revoke(id uuid.UUID) error {
+	return r.db.Model(&model.Session{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *SessionRepository) RevokeAllByUserID(userID uuid.UUID) error {
+	return r.db.Model(&model.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *SessionRepository) RevokeAllByUserIDExcept(userID, keepID uuid.UUID) error {
+	return r.db.Model(&model.Session{}).
+		Where("user_id = ? AND id <> ? AND revoked_at IS NULL", userID, keepID).
+		Update("revoked_at", time.Now()).Error
+}
+
+type RefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+func (r *RefreshTokenRepository) Create(token *model.RefreshToken) error {
+	return r.db.Create(token).Error
+}
+
+func (r *RefreshTokenRepository) FindByHash(tokenHash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	err := r.db.Preload("Session").Preload("Session.User").First(&token, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *RefreshTokenRepository) MarkUsed(id uuid.UUID) (bool, error) {
+	result := r.db.Model(&model.RefreshToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *RefreshTokenRepository) MarkUsedBySessionID(sessionID uuid.UUID) error {
+	return r.db.Model(&model.RefreshToken{}).
+		Where("session_id = ? AND used_at IS NULL", sessionID).
+		Update("used_at", time.Now()).Error
+}
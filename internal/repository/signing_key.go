@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"time"
+
+	"dormi-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type SigningKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewSigningKeyRepository(db *gorm.DB) *SigningKeyRepository {
+	return &SigningKeyRepository{db: db}
+}
+
+func (r *SigningKeyRepository) FindUsable(now time.Time) ([]model.SigningKey, error) {
+	var keys []model.SigningKey
+	err := r.db.
+		Where("active = ? OR retires_at > ?", true, now).
+		Order("created_at DESC").
+		Find(&keys).Error
+	return keys, err
+}
+
+func (r *SigningKeyRepository) Rotate(key *model.SigningKey, retiresAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.SigningKey{}).
+			Where("active = ?", true).
+			Updates(map[string]interface{}{"active": false, "retires_at": retiresAt}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("active = ? AND retires_at < ?", false, time.Now()).
+			Delete(&model.SigningKey{}).Error; err != nil {
+			return err
+		}
+
+		key.Active = true
+		return tx.Create(key).Error
+	})
+}
@@ -1,8 +1,12 @@
 package repository
 
 import (
+	"errors"
+	"time"
+
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -17,11 +21,38 @@ func NewStudentRepository(db *gorm.DB) *StudentRepository {
 }
 
 func (r *StudentRepository) Create(student *model.Student) error {
-	return r.db.Create(student).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(student).Error; err != nil {
+			return err
+		}
+		return openAssignment(tx, student, "", student.CreatedAt)
+	})
 }
 
-func (r *StudentRepository) CreateBatch(students []model.Student) error {
-	return r.db.CreateInBatches(students, 100).Error
+func (r *StudentRepository) Import(created, updated []model.Student) error {
+	now := time.Now()
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := releaseBeds(tx, updated); err != nil {
+			return err
+		}
+		for i := range updated {
+			if err := saveStudent(tx, &updated[i], "", now); err != nil {
+				return err
+			}
+		}
+		if len(created) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(created, 100).Error; err != nil {
+			return err
+		}
+		for i := range created {
+			if err := openAssignment(tx, &created[i], "", created[i].CreatedAt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (r *StudentRepository) FindByID(id uuid.UUID) (*model.Student, error) {
@@ -33,6 +64,12 @@ func (r *StudentRepository) FindByID(id uuid.UUID) (*model.Student, error) {
 	return &student, nil
 }
 
+func (r *StudentRepository) FindByIDs(ids []uuid.UUID) ([]model.Student, error) {
+	var students []model.Student
+	err := r.db.Where("id IN ?", ids).Order("student_number").Find(&students).Error
+	return students, err
+}
+
 func (r *StudentRepository) FindByStudentNumber(studentNumber string) (*model.Student, error) {
 	var student model.Student
 	err := r.db.First(&student, "student_number = ?", studentNumber).Error
@@ -42,9 +79,48 @@ func (r *StudentRepository) FindByStudentNumber(studentNumber string) (*model.St
 	return &student, nil
 }
 
+var studentPage = pagination.Spec[model.Student]{
+	IDColumn:    "id",
+	ID:          func(s *model.Student) uuid.UUID { return s.ID },
+	DefaultSort: "studentNumber",
+	Fields: map[string]pagination.Field[model.Student]{
+		"studentNumber": {Column: "student_number", Value: func(s *model.Student) interface{} { return s.StudentNumber }},
+		"name":          {Column: "name", Value: func(s *model.Student) interface{} { return s.Name }},
+		"grade":         {Column: "grade", Value: func(s *model.Student) interface{} { return s.Grade }},
+		"roomNumber":    {Column: "room_number", Value: func(s *model.Student) interface{} { return s.RoomNumber }},
+		"createdAt":     {Column: "created_at", Value: func(s *model.Student) interface{} { return s.CreatedAt }},
+	},
+}
+
+var deletedStudentPage = pagination.Spec[model.Student]{
+	IDColumn:    "id",
+	ID:          func(s *model.Student) uuid.UUID { return s.ID },
+	DefaultSort: "-deletedAt",
+	Fields: map[string]pagination.Field[model.Student]{
+		"deletedAt":     {Column: "deleted_at", Value: func(s *model.Student) interface{} { return s.DeletedAt.Time }},
+		"studentNumber": {Column: "student_number", Value: func(s *model.Student) interface{} { return s.StudentNumber }},
+		"name":          {Column: "name", Value: func(s *model.Student) interface{} { return s.Name }},
+		"grade":         {Column: "grade", Value: func(s *model.Student) interface{} { return s.Grade }},
+	},
+}
+
 func (r *StudentRepository) FindAll(query dto.StudentQuery) ([]model.Student, error) {
 	var students []model.Student
+	err := r.filter(query).Order("student_number").Find(&students).Error
+	return students, err
+}
+
+func (r *StudentRepository) FindAllWithBuilding(query dto.StudentQuery) ([]model.Student, error) {
+	var students []model.Student
+	err := r.filter(query).Preload("Bed.Room.Floor.Building").Order("student_number").Find(&students).Error
+	return students, err
+}
+
+func (r *StudentRepository) FindPage(query dto.StudentQuery, page dto.PageQuery) ([]model.Student, *dto.Pagination, error) {
+	return pagination.Find(r.filter(query), page, studentPage)
+}
 
+func (r *StudentRepository) filter(query dto.StudentQuery) *gorm.DB {
 	db := r.db.Model(&model.Student{})
 
 	if query.Search != "" {
@@ -54,21 +130,171 @@ func (r *StudentRepository) FindAll(query dto.StudentQuery) ([]model.Student, er
 	if query.Grade > 0 {
 		db = db.Where("grade = ?", query.Grade)
 	}
-	if query.Room != "" {
-		db = db.Where("room_number = ?", query.Room)
+	if query.Room != "" || query.BuildingID != uuid.Nil {
+		beds := r.db.Model(&model.Bed{}).
+			Select("beds.id").
+			Joins("JOIN rooms ON rooms.id = beds.room_id").
+			Joins("JOIN floors ON floors.id = rooms.floor_id")
+		if query.Room != "" {
+			beds = beds.Where("rooms.number = ?", query.Room)
+		}
+		if query.BuildingID != uuid.Nil {
+			beds = beds.Where("floors.building_id = ?", query.BuildingID)
+		}
+		db = db.Where("bed_id IN (?)", beds)
 	}
 
-	err := db.Order("student_number").Find(&students).Error
-
-	return students, err
+	return db
 }
 
 func (r *StudentRepository) Update(student *model.Student) error {
-	return r.db.Save(student).Error
+	return r.Move(student, "", time.Now())
+}
+
+func (r *StudentRepository) Move(student *model.Student, reason string, at time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return saveStudent(tx, student, reason, at)
+	})
 }
 
 func (r *StudentRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&model.Student{}, "id = ?", id).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := closeAssignment(tx, id, time.Now()); err != nil {
+			return err
+		}
+		return tx.Delete(&model.Student{}, "id = ?", id).Error
+	})
+}
+
+func (r *StudentRepository) Rollover(promotions map[int][]uuid.UUID, graduates []uuid.UUID, at time.Time) (int64, error) {
+	var archived int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for grade, ids := range promotions {
+			result := tx.Model(&model.Student{}).
+				Where("id IN ? AND grade = ?", ids, grade).
+				Update("grade", grade+1)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected != int64(len(ids)) {
+				return errors.New("students have changed since the preview")
+			}
+		}
+
+		if len(graduates) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&model.RoomAssignment{}).
+			Where("student_id IN ? AND ended_at IS NULL", graduates).
+			Update("ended_at", at).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.Student{}).
+			Where("id IN ? AND grade = ?", graduates, model.FinalGrade).
+			Updates(map[string]interface{}{
+				"status":       model.StudentStatusAlumni,
+				"graduated_at": at,
+				"bed_id":       nil,
+				"deleted_at":   at,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(graduates)) {
+			return errors.New("students have changed since the preview")
+		}
+
+		result = tx.Model(&model.Point{}).
+			Where("student_id IN ? AND archived_at IS NULL", graduates).
+			Update("archived_at", at)
+		if result.Error != nil {
+			return result.Error
+		}
+		archived = result.RowsAffected
+		return nil
+	})
+	return archived, err
+}
+
+func (r *StudentRepository) FindDeleted(query dto.StudentQuery, page dto.PageQuery) ([]model.Student, *dto.Pagination, error) {
+	db := r.db.Unscoped().Model(&model.Student{}).Where("deleted_at IS NOT NULL AND status <> ?", model.StudentStatusAlumni)
+
+	if query.Search != "" {
+		search := "%" + query.Search + "%"
+		db = db.Where("name ILIKE ? OR student_number ILIKE ?", search, search)
+	}
+	if query.Grade > 0 {
+		db = db.Where("grade = ?", query.Grade)
+	}
+
+	return pagination.Find(db, page, deletedStudentPage)
+}
+
+func (r *StudentRepository) FindDeletedByID(id uuid.UUID) (*model.Student, error) {
+	var student model.Student
+	err := r.db.Unscoped().Where("deleted_at IS NOT NULL AND status <> ?", model.StudentStatusAlumni).First(&student, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &student, nil
+}
+
+func (r *StudentRepository) Restore(student *model.Student, at time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		student.DeletedAt = gorm.DeletedAt{}
+		result := tx.Unscoped().Model(student).
+			Where("deleted_at IS NOT NULL AND status <> ?", model.StudentStatusAlumni).
+			Updates(map[string]interface{}{
+				"bed_id":      student.BedID,
+				"room_number": student.RoomNumber,
+				"deleted_at":  nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("deleted student not found")
+		}
+
+		return openAssignment(tx, student, "restored", at)
+	})
+}
+
+func (r *StudentRepository) Purge(id uuid.UUID, deletePoints bool) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if deletePoints {
+			if err := tx.Where("student_id = ?", id).Delete(&model.PointProposal{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("student_id = ?", id).Delete(&model.Point{}).Error; err != nil {
+				return err
+			}
+		} else {
+			var points, proposals int64
+			if err := tx.Model(&model.Point{}).Where("student_id = ?", id).Count(&points).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&model.PointProposal{}).Where("student_id = ?", id).Count(&proposals).Error; err != nil {
+				return err
+			}
+			if points+proposals > 0 {
+				return errors.New("student has point records")
+			}
+		}
+
+		result := tx.Unscoped().
+			Where("deleted_at IS NOT NULL AND status <> ?", model.StudentStatusAlumni).
+			Delete(&model.Student{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("deleted student not found")
+		}
+		return nil
+	})
 }
 
 func (r *StudentRepository) ExistsByStudentNumber(studentNumber string) (bool, error) {
@@ -76,3 +302,50 @@ func (r *StudentRepository) ExistsByStudentNumber(studentNumber string) (bool, e
 	err := r.db.Model(&model.Student{}).Where("student_number = ?", studentNumber).Count(&count).Error
 	return count > 0, err
 }
+
+func releaseBeds(tx *gorm.DB, students []model.Student) error {
+	targets := map[uuid.UUID]*uuid.UUID{}
+	ids := []uuid.UUID{}
+	for i := range students {
+		if students[i].BedID != nil {
+			targets[students[i].ID] = students[i].BedID
+			ids = append(ids, students[i].ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var current []model.Student
+	if err := tx.Select("id", "bed_id").Where("id IN ?", ids).Find(&current).Error; err != nil {
+		return err
+	}
+
+	var moving []uuid.UUID
+	for _, student := range current {
+		if student.BedID != nil && !model.SameBed(student.BedID, targets[student.ID]) {
+			moving = append(moving, student.ID)
+		}
+	}
+	if len(moving) == 0 {
+		return nil
+	}
+	return tx.Model(&model.Student{}).Where("id IN ?", moving).Update("bed_id", nil).Error
+}
+
+func saveStudent(tx *gorm.DB, student *model.Student, reason string, at time.Time) error {
+	var current model.Student
+	if err := tx.Select("bed_id").First(&current, "id = ?", student.ID).Error; err != nil {
+		return err
+	}
+	if err := tx.Omit("Bed").Save(student).Error; err != nil {
+		return err
+	}
+	if model.SameBed(current.BedID, student.BedID) {
+		return nil
+	}
+	if err := closeAssignment(tx, student.ID, at); err != nil {
+		return err
+	}
+	return openAssignment(tx, student, reason, at)
+}
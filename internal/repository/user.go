@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -37,16 +39,93 @@ func (r *UserRepository) FindByEmail(email string) (*model.User, error) {
 	return &user, nil
 }
 
-func (r *UserRepository) FindAll() ([]model.User, error) {
+func (r *UserRepository) FindByEmailIgnoreCase(email string) (*model.User, error) {
+	var user model.User
+	err := r.db.First(&user, "LOWER(email) = LOWER(?)", email).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+var userPage = pagination.Spec[model.User]{
+	IDColumn:    "id",
+	ID:          func(u *model.User) uuid.UUID { return u.ID },
+	DefaultSort: "name",
+	Fields: map[string]pagination.Field[model.User]{
+		"name":      {Column: "name", Value: func(u *model.User) interface{} { return u.Name }},
+		"email":     {Column: "email", Value: func(u *model.User) interface{} { return u.Email }},
+		"role":      {Column: "role", Value: func(u *model.User) interface{} { return u.Role }},
+		"createdAt": {Column: "created_at", Value: func(u *model.User) interface{} { return u.CreatedAt }},
+	},
+}
+
+func (r *UserRepository) FindAll(query dto.UserQuery) ([]model.User, *dto.Pagination, error) {
+	db := r.db.Model(&model.User{})
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.Role != "" {
+		db = db.Where("role = ?", query.Role)
+	}
+	if query.ServiceAccount != nil {
+		db = db.Where("service_account = ?", *query.ServiceAccount)
+	}
+
+	return pagination.Find(db, query.PageQuery, userPage)
+}
+
+func (r *UserRepository) FindActiveByIDs(ids []uuid.UUID) ([]model.User, error) {
 	var users []model.User
-	err := r.db.Find(&users).Error
+	err := r.db.Where("id IN ? AND status = ?", ids, model.UserStatusActive).Find(&users).Error
 	return users, err
 }
 
+func (r *UserRepository) ExistsByEmail(email string) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.User{}).Where("LOWER(email) = LOWER(?)", email).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *UserRepository) CountActiveByRole(role model.Role) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.User{}).
+		Where("role = ? AND status = ? AND service_account = false", role, model.UserStatusActive).
+		Count(&count).Error
+	return count, err
+}
+
 func (r *UserRepository) Update(user *model.User) error {
-	return r.db.Save(user).Error
+	return r.db.Omit("token_version", "totp_last_counter").Save(user).Error
+}
+
+func (r *UserRepository) UpdateAndInvalidateTokens(user *model.User) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("token_version", "totp_last_counter").Save(user).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&model.User{}).
+			Where("id = ?", user.ID).
+			UpdateColumn("token_version", gorm.Expr("token_version + 1")).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&model.User{}).
+			Select("token_version").
+			Where("id = ?", user.ID).
+			Scan(&user.TokenVersion).Error
+	})
+}
+
+func (r *UserRepository) UpdateTOTP(user *model.User) error {
+	return r.db.Model(user).Select("totp_secret", "totp_enabled", "totp_last_counter").Updates(user).Error
 }
 
-func (r *UserRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&model.User{}, "id = ?", id).Error
+func (r *UserRepository) AdvanceTOTPCounter(id uuid.UUID, counter int64) (bool, error) {
+	result := r.db.Model(&model.User{}).
+		Where("id = ? AND totp_last_counter < ?", id, counter).
+		Update("totp_last_counter", counter)
+	return result.RowsAffected == 1, result.Error
 }
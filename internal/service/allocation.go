@@ -0,0 +1,325 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"dormi-api/internal/allocation"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type AllocationService struct {
+	allocationRepo *repository.AllocationRepository
+	studentRepo    *repository.StudentRepository
+	roomRepo       *repository.RoomRepository
+}
+
+func NewAllocationService(allocationRepo *repository.AllocationRepository, studentRepo *repository.StudentRepository, roomRepo *repository.RoomRepository) *AllocationService {
+	return &AllocationService{allocationRepo: allocationRepo, studentRepo: studentRepo, roomRepo: roomRepo}
+}
+
+func (s *AllocationService) Create(req dto.CreateAllocationPlanRequest, createdBy uuid.UUID) (*model.AllocationPlan, error) {
+	var students []model.Student
+	var err error
+	if len(req.StudentIDs) > 0 {
+		students, err = s.studentRepo.FindByIDs(req.StudentIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(students) != len(uniqueIDs(req.StudentIDs)) {
+			return nil, errors.New("student not found")
+		}
+	} else {
+		students, err = s.studentRepo.FindAll(dto.StudentQuery{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(students) == 0 {
+		return nil, errors.New("no students to allocate")
+	}
+
+	inPlan := make(map[uuid.UUID]bool, len(students))
+	for _, student := range students {
+		inPlan[student.ID] = true
+	}
+
+	requests := make([]model.RoommateRequest, 0, len(req.RoommateRequests))
+	for _, r := range req.RoommateRequests {
+		if !inPlan[r.StudentID] || !inPlan[r.RoommateID] {
+			return nil, errors.New("roommate request refers to a student outside the plan")
+		}
+		if r.StudentID == r.RoommateID {
+			return nil, errors.New("student cannot request themselves as a roommate")
+		}
+		requests = append(requests, model.RoommateRequest{StudentID: r.StudentID, RoommateID: r.RoommateID})
+	}
+
+	fixed := make(map[uuid.UUID]uuid.UUID, len(req.FixedAssignments))
+	for _, f := range req.FixedAssignments {
+		if !inPlan[f.StudentID] {
+			return nil, errors.New("fixed assignment refers to a student outside the plan")
+		}
+		fixed[f.StudentID] = f.BedID
+	}
+
+	rooms, err := s.inventory(inPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	bedRooms := map[uuid.UUID]uuid.UUID{}
+	for _, room := range rooms {
+		for _, bedID := range room.Beds {
+			bedRooms[bedID] = room.ID
+		}
+	}
+
+	candidates := make([]allocation.Student, 0, len(students))
+	for _, student := range students {
+		candidate := allocation.Student{
+			ID:           student.ID,
+			Grade:        student.Grade,
+			Gender:       student.Gender,
+			CurrentBedID: student.BedID,
+		}
+		if student.BedID != nil {
+			if roomID, ok := bedRooms[*student.BedID]; ok {
+				candidate.CurrentRoomID = &roomID
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	result := allocation.Allocate(rooms, candidates, allocation.Options{
+		GroupByGrade:     req.GroupByGrade,
+		KeepCurrentRoom:  req.KeepCurrentRoom,
+		RoommateRequests: requests,
+		Fixed:            fixed,
+	})
+
+	plan := &model.AllocationPlan{
+		Name:             req.Name,
+		Status:           model.AllocationPlanStatusDraft,
+		GroupByGrade:     req.GroupByGrade,
+		KeepCurrentRoom:  req.KeepCurrentRoom,
+		RoommateRequests: requests,
+		CreatedBy:        &createdBy,
+	}
+	for _, a := range result.Assignments {
+		bedID := a.BedID
+		plan.Items = append(plan.Items, model.AllocationItem{
+			StudentID: a.StudentID,
+			BedID:     &bedID,
+			Fixed:     a.Fixed,
+		})
+	}
+	for _, u := range result.Unassigned {
+		plan.Items = append(plan.Items, model.AllocationItem{
+			StudentID: u.StudentID,
+			Note:      u.Reason,
+		})
+	}
+
+	if err := s.allocationRepo.Create(plan); err != nil {
+		return nil, err
+	}
+
+	return s.allocationRepo.FindByID(plan.ID)
+}
+
+func (s *AllocationService) GetAll(query dto.PageQuery) ([]model.AllocationPlan, *dto.Pagination, error) {
+	return s.allocationRepo.FindAll(query)
+}
+
+func (s *AllocationService) GetByID(id uuid.UUID) (*model.AllocationPlan, error) {
+	plan, err := s.allocationRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("allocation plan not found")
+	}
+	return plan, nil
+}
+
+func (s *AllocationService) UpdateItem(planID, itemID uuid.UUID, req dto.UpdateAllocationItemRequest) (*model.AllocationPlan, error) {
+	plan, err := s.draft(planID)
+	if err != nil {
+		return nil, err
+	}
+
+	var item *model.AllocationItem
+	inPlan := make(map[uuid.UUID]bool, len(plan.Items))
+	for i := range plan.Items {
+		inPlan[plan.Items[i].StudentID] = true
+		if plan.Items[i].ID == itemID {
+			item = &plan.Items[i]
+		}
+	}
+	if item == nil {
+		return nil, errors.New("allocation item not found")
+	}
+
+	if req.BedID == nil {
+		item.BedID = nil
+		item.Fixed = false
+		item.Note = ""
+	} else {
+		if err := s.checkBed(plan, item, *req.BedID, inPlan); err != nil {
+			return nil, err
+		}
+		item.BedID = req.BedID
+		item.Fixed = true
+		item.Note = ""
+	}
+
+	if err := s.allocationRepo.UpdateItem(item); err != nil {
+		return nil, err
+	}
+
+	return s.allocationRepo.FindByID(plan.ID)
+}
+
+func (s *AllocationService) Commit(id uuid.UUID) (*model.AllocationPlan, error) {
+	plan, err := s.draft(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.allocationRepo.Commit(plan, "allocation: "+plan.Name, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return s.allocationRepo.FindByID(plan.ID)
+}
+
+func (s *AllocationService) Delete(id uuid.UUID) error {
+	if _, err := s.draft(id); err != nil {
+		return err
+	}
+	return s.allocationRepo.Delete(id)
+}
+
+func (s *AllocationService) draft(id uuid.UUID) (*model.AllocationPlan, error) {
+	plan, err := s.allocationRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("allocation plan not found")
+	}
+	if plan.Status != model.AllocationPlanStatusDraft {
+		return nil, errors.New("allocation plan is not a draft")
+	}
+	return plan, nil
+}
+
+func (s *AllocationService) inventory(inPlan map[uuid.UUID]bool) ([]allocation.Room, error) {
+	rooms, err := s.roomRepo.FindAll(dto.RoomQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	var bedIDs []uuid.UUID
+	for _, room := range rooms {
+		for _, bed := range room.Beds {
+			bedIDs = append(bedIDs, bed.ID)
+		}
+	}
+
+	occupants, err := s.roomRepo.FindOccupants(bedIDs)
+	if err != nil {
+		return nil, err
+	}
+	occupied := make(map[uuid.UUID]model.Student, len(occupants))
+	for _, occupant := range occupants {
+		if !inPlan[occupant.ID] {
+			occupied[*occupant.BedID] = occupant
+		}
+	}
+
+	result := make([]allocation.Room, 0, len(rooms))
+	for _, room := range rooms {
+		candidate := allocation.Room{ID: room.ID, Gender: room.Gender}
+		for _, bed := range room.Beds {
+			resident, ok := occupied[bed.ID]
+			if !ok {
+				candidate.Beds = append(candidate.Beds, bed.ID)
+				continue
+			}
+			candidate.Residents = append(candidate.Residents, allocation.Student{
+				ID:     resident.ID,
+				Grade:  resident.Grade,
+				Gender: resident.Gender,
+			})
+		}
+		result = append(result, candidate)
+	}
+	return result, nil
+}
+
+func (s *AllocationService) checkBed(plan *model.AllocationPlan, item *model.AllocationItem, bedID uuid.UUID, inPlan map[uuid.UUID]bool) error {
+	bed, err := s.roomRepo.FindBedByID(bedID)
+	if err != nil {
+		return errors.New("bed not found")
+	}
+	room, err := s.roomRepo.FindByID(bed.RoomID)
+	if err != nil {
+		return errors.New("room not found")
+	}
+
+	if item.Student == nil {
+		return errors.New("student not found")
+	}
+	gender := item.Student.Gender
+	if gender == "" {
+		return errors.New("student gender is not set")
+	}
+	if room.Gender != "" && room.Gender != gender {
+		return errors.New("room is designated for a different gender")
+	}
+
+	roomBeds := make(map[uuid.UUID]bool, len(room.Beds))
+	bedIDs := make([]uuid.UUID, 0, len(room.Beds))
+	for _, b := range room.Beds {
+		roomBeds[b.ID] = true
+		bedIDs = append(bedIDs, b.ID)
+	}
+
+	for _, other := range plan.Items {
+		if other.ID == item.ID || other.BedID == nil {
+			continue
+		}
+		if *other.BedID == bedID {
+			return errors.New("bed is already assigned in this plan")
+		}
+		if roomBeds[*other.BedID] && other.Student != nil && other.Student.Gender != gender {
+			return errors.New("room is assigned to a different gender in this plan")
+		}
+	}
+
+	occupants, err := s.roomRepo.FindOccupants(bedIDs)
+	if err != nil {
+		return err
+	}
+	for _, occupant := range occupants {
+		if inPlan[occupant.ID] {
+			continue
+		}
+		if *occupant.BedID == bedID {
+			return errors.New("bed is occupied by a student outside the plan")
+		}
+		if occupant.Gender != gender {
+			return errors.New("room is assigned to a different gender")
+		}
+	}
+
+	return nil
+}
+
+func uniqueIDs(ids []uuid.UUID) map[uuid.UUID]bool {
+	unique := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		unique[id] = true
+	}
+	return unique
+}
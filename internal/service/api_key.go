@@ -0,0 +1,170 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/datatypes"
+)
+
+const (
+	apiKeyPrefix           = "dormi"
+	apiKeyLastUsedInterval = time.Minute
+)
+
+type APIKeyService struct {
+	userRepo   *repository.UserRepository
+	apiKeyRepo *repository.APIKeyRepository
+}
+
+func NewAPIKeyService(userRepo *repository.UserRepository, apiKeyRepo *repository.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{userRepo: userRepo, apiKeyRepo: apiKeyRepo}
+}
+
+func (s *APIKeyService) CreateServiceAccount(req dto.CreateServiceAccountRequest) (*model.User, error) {
+	password, err := generateRandomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New()
+	user := &model.User{
+		ID:             id,
+		Email:          "svc-" + id.String() + "@service-accounts.local",
+		Password:       string(hashedPassword),
+		Name:           req.Name,
+		Role:           model.Role(req.Role),
+		Status:         model.UserStatusActive,
+		ServiceAccount: true,
+	}
+
+	if err := s.userRepo.Create(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *APIKeyService) GetServiceAccounts(page dto.PageQuery) ([]model.User, *dto.Pagination, error) {
+	serviceAccount := true
+	return s.userRepo.FindAll(dto.UserQuery{ServiceAccount: &serviceAccount, PageQuery: page})
+}
+
+func (s *APIKeyService) CreateKey(serviceAccountID uuid.UUID, req dto.CreateAPIKeyRequest, createdBy uuid.UUID) (*model.APIKey, string, error) {
+	user, err := s.userRepo.FindByID(serviceAccountID)
+	if err != nil || !user.ServiceAccount {
+		return nil, "", errors.New("service account not found")
+	}
+
+	if !user.IsActive() {
+		return nil, "", errors.New("service account is deactivated")
+	}
+
+	scopes := make([]string, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if !isKnownPermission(model.Permission(scope)) {
+			return nil, "", errors.New("unknown scope: " + scope)
+		}
+		scopes = append(scopes, scope)
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, "", errors.New("expiration must be in the future")
+	}
+
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	prefix := hex.EncodeToString(b)
+
+	secret, err := generateRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	rawKey := apiKeyPrefix + "_" + prefix + "_" + secret
+
+	key := &model.APIKey{
+		UserID:    user.ID,
+		Name:      req.Name,
+		Prefix:    prefix,
+		KeyHash:   hashToken(rawKey),
+		Scopes:    datatypes.NewJSONSlice(scopes),
+		CreatedBy: createdBy,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	if err := s.apiKeyRepo.Create(key); err != nil {
+		return nil, "", err
+	}
+
+	return key, rawKey, nil
+}
+
+func (s *APIKeyService) GetKeys(serviceAccountID uuid.UUID, query dto.PageQuery) ([]model.APIKey, *dto.Pagination, error) {
+	user, err := s.userRepo.FindByID(serviceAccountID)
+	if err != nil || !user.ServiceAccount {
+		return nil, nil, errors.New("service account not found")
+	}
+	return s.apiKeyRepo.FindByUserID(user.ID, query)
+}
+
+func (s *APIKeyService) Revoke(id uuid.UUID) (*model.APIKey, error) {
+	key, err := s.apiKeyRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("api key not found")
+	}
+
+	if key.RevokedAt != nil {
+		return nil, errors.New("api key is already revoked")
+	}
+
+	if err := s.apiKeyRepo.Revoke(id); err != nil {
+		return nil, err
+	}
+
+	return s.apiKeyRepo.FindByID(id)
+}
+
+func (s *APIKeyService) Authenticate(rawKey string) (*model.APIKey, error) {
+	if !strings.HasPrefix(rawKey, apiKeyPrefix+"_") {
+		return nil, errors.New("invalid api key")
+	}
+
+	key, err := s.apiKeyRepo.FindByHash(hashToken(rawKey))
+	if err != nil || key.User == nil {
+		return nil, errors.New("invalid api key")
+	}
+
+	now := time.Now()
+	if key.RevokedAt != nil {
+		return nil, errors.New("api key has been revoked")
+	}
+	if key.ExpiresAt != nil && now.After(*key.ExpiresAt) {
+		return nil, errors.New("api key expired")
+	}
+	if !key.User.ServiceAccount || !key.User.IsActive() {
+		return nil, errors.New("invalid api key")
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > apiKeyLastUsedInterval {
+		s.apiKeyRepo.UpdateLastUsedAt(key.ID, now)
+		key.LastUsedAt = &now
+	}
+
+	return key, nil
+}
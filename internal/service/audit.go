@@ -18,7 +18,13 @@ func NewAuditService(auditRepo *repository.AuditRepository) *AuditService {
 	return &AuditService{auditRepo: auditRepo}
 }
 
-func (s *AuditService) Log(userID uuid.UUID, action model.AuditAction, entityType string, entityID *uuid.UUID, details interface{}, ipAddress string) error {
+type Actor struct {
+	UserID         uuid.UUID
+	APIKeyID       *uuid.UUID
+	ImpersonatorID *uuid.UUID
+}
+
+func (s *AuditService) Log(actor Actor, action model.AuditAction, entityType string, entityID *uuid.UUID, details interface{}, ipAddress string) error {
 	var detailsJSON []byte
 	if details != nil {
 		var err error
@@ -29,23 +35,21 @@ func (s *AuditService) Log(userID uuid.UUID, action model.AuditAction, entityTyp
 	}
 
 	log := &model.AuditLog{
-		UserID:     userID,
-		Action:     action,
-		EntityType: entityType,
-		EntityID:   entityID,
-		Details:    detailsJSON,
-		IPAddress:  ipAddress,
+		Action:         action,
+		EntityType:     entityType,
+		EntityID:       entityID,
+		Details:        detailsJSON,
+		IPAddress:      ipAddress,
+		APIKeyID:       actor.APIKeyID,
+		ImpersonatorID: actor.ImpersonatorID,
+	}
+	if actor.UserID != uuid.Nil {
+		log.UserID = &actor.UserID
 	}
 
 	return s.auditRepo.Create(log)
 }
 
-func (s *AuditService) GetAll(query dto.AuditQuery) ([]model.AuditLog, int64, error) {
-	if query.Page < 1 {
-		query.Page = 1
-	}
-	if query.Limit < 1 || query.Limit > 100 {
-		query.Limit = 20
-	}
+func (s *AuditService) GetAll(query dto.AuditQuery) ([]model.AuditLog, *dto.Pagination, error) {
 	return s.auditRepo.FindAll(query)
 }
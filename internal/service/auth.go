@@ -1,12 +1,17 @@
 package service
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"time"
 
 	"dormi-api/internal/config"
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
+	"dormi-api/internal/password"
 	"dormi-api/internal/repository"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,91 +19,499 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	tokenPurposeMFA         = "mfa"
+	mfaTokenTTL             = 5 * time.Minute
+	sessionLastSeenInterval = time.Minute
+	sessionUserAgentMaxLen  = 500
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrAccountDeactivated = errors.New("account is deactivated")
+)
+
 type AuthService struct {
-	userRepo *repository.UserRepository
-	cfg      *config.Config
+	userRepo         *repository.UserRepository
+	sessionRepo      *repository.SessionRepository
+	refreshTokenRepo *repository.RefreshTokenRepository
+	historyRepo      *repository.PasswordHistoryRepository
+	throttle         *LoginThrottleService
+	mfa              *MFAService
+	signingKeys      *SigningKeyService
+	permissions      *PermissionService
+	passwordPolicy   *password.Policy
+	cfg              *config.Config
 }
 
-func NewAuthService(userRepo *repository.UserRepository, cfg *config.Config) *AuthService {
-	return &AuthService{userRepo: userRepo, cfg: cfg}
+func NewAuthService(userRepo *repository.UserRepository, sessionRepo *repository.SessionRepository, refreshTokenRepo *repository.RefreshTokenRepository, historyRepo *repository.PasswordHistoryRepository, throttle *LoginThrottleService, mfa *MFAService, signingKeys *SigningKeyService, permissions *PermissionService, passwordPolicy *password.Policy, cfg *config.Config) *AuthService {
+	return &AuthService{userRepo: userRepo, sessionRepo: sessionRepo, refreshTokenRepo: refreshTokenRepo, historyRepo: historyRepo, throttle: throttle, mfa: mfa, signingKeys: signingKeys, permissions: permissions, passwordPolicy: passwordPolicy, cfg: cfg}
 }
 
 type Claims struct {
-	UserID string     `json:"userId"`
-	Email  string     `json:"email"`
-	Role   model.Role `json:"role"`
+	UserID       string     `json:"userId"`
+	Email        string     `json:"email"`
+	Role         model.Role `json:"role"`
+	SessionID    string     `json:"sid,omitempty"`
+	TokenVersion int        `json:"ver"`
+	Purpose      string     `json:"purpose,omitempty"`
+	AuthMethod   string     `json:"method,omitempty"`
+	Impersonator string     `json:"imp,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func (s *AuthService) Login(req dto.LoginRequest) (*dto.LoginResponse, error) {
+type LoginResult struct {
+	Token                  string
+	RefreshToken           string
+	ExpiresAt              *time.Time
+	MFARequired            bool
+	MFAToken               string
+	MFAEnrollmentRequired  bool
+	PasswordChangeRequired bool
+	User                   *model.User
+	Impersonator           *model.User
+}
+
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+func (c ClientInfo) userAgent() string {
+	if len(c.UserAgent) > sessionUserAgentMaxLen {
+		return c.UserAgent[:sessionUserAgentMaxLen]
+	}
+	return c.UserAgent
+}
+
+func (s *AuthService) Login(req dto.LoginRequest, client ClientInfo) (*LoginResult, error) {
+	ipAddress := client.IPAddress
+	if err := s.throttle.Check(req.Email, ipAddress); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.FindByEmail(req.Email)
-	if err != nil {
-		return nil, errors.New("invalid credentials")
+	if err != nil || user.ServiceAccount {
+		return nil, s.loginFailed(req.Email, ipAddress)
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
-		return nil, errors.New("invalid credentials")
+		return nil, s.loginFailed(req.Email, ipAddress)
+	}
+
+	s.throttle.RecordSuccess(req.Email, ipAddress)
+
+	if !user.IsActive() {
+		return nil, ErrAccountDeactivated
 	}
 
-	token, err := s.generateToken(user)
+	if user.TOTPEnabled {
+		return s.mfaChallenge(user, model.AuthMethodPassword)
+	}
+
+	return s.startSession(user, model.AuthMethodPassword, client)
+}
+
+func (s *AuthService) VerifyMFA(req dto.MFALoginRequest, client ClientInfo) (*LoginResult, error) {
+	ipAddress := client.IPAddress
+	claims, err := s.parseToken(req.MFAToken)
+	if err != nil || claims.Purpose != tokenPurposeMFA {
+		return nil, errors.New("invalid or expired mfa token")
+	}
+
+	if err := s.throttle.Check(claims.Email, ipAddress); err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
 	if err != nil {
-		return nil, errors.New("failed to generate token")
+		return nil, errors.New("invalid or expired mfa token")
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil || user.TokenVersion != claims.TokenVersion {
+		return nil, errors.New("invalid or expired mfa token")
+	}
+
+	if !user.IsActive() {
+		return nil, ErrAccountDeactivated
+	}
+
+	if req.Code == "" && req.RecoveryCode == "" {
+		return nil, errors.New("code or recovery code is required")
+	}
+
+	if err := s.mfa.Verify(user, req.Code, req.RecoveryCode); err != nil {
+		if lockErr := s.throttle.RecordFailure(user.Email, ipAddress); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, err
+	}
+
+	s.throttle.RecordSuccess(user.Email, ipAddress)
+
+	authMethod := claims.AuthMethod
+	if authMethod == "" {
+		authMethod = model.AuthMethodPassword
+	}
+
+	return s.startSession(user, authMethod, client)
+}
+
+func (s *AuthService) LoginWithIdentity(user *model.User, authMethod string, client ClientInfo) (*LoginResult, error) {
+	if user.ServiceAccount {
+		return nil, ErrInvalidCredentials
 	}
 
-	return &dto.LoginResponse{
-		Token: token,
-		User: dto.UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  string(user.Role),
+	if !user.IsActive() {
+		return nil, ErrAccountDeactivated
+	}
+
+	if user.TOTPEnabled {
+		return s.mfaChallenge(user, authMethod)
+	}
+
+	return s.startSession(user, authMethod, client)
+}
+
+func (s *AuthService) MFAEnrollmentRequired(user *model.User) bool {
+	return s.mfa.EnrollmentRequired(user)
+}
+
+func (s *AuthService) PasswordChangeRequired(user *model.User, authMethod string) bool {
+	return user.MustChangePassword && authMethod == model.AuthMethodPassword
+}
+
+func (s *AuthService) mfaChallenge(user *model.User, authMethod string) (*LoginResult, error) {
+	expiresAt := time.Now().Add(mfaTokenTTL)
+	claims := &Claims{
+		UserID:       user.ID.String(),
+		Email:        user.Email,
+		Role:         user.Role,
+		TokenVersion: user.TokenVersion,
+		Purpose:      tokenPurposeMFA,
+		AuthMethod:   authMethod,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
+	}
+
+	token, err := s.signToken(claims)
+	if err != nil {
+		return nil, errors.New("failed to generate token")
+	}
+
+	return &LoginResult{
+		MFARequired: true,
+		MFAToken:    token,
+		ExpiresAt:   &expiresAt,
+		User:        user,
 	}, nil
 }
 
-func (s *AuthService) generateToken(user *model.User) (string, error) {
+func (s *AuthService) loginFailed(email, ipAddress string) error {
+	if err := s.throttle.RecordFailure(email, ipAddress); err != nil {
+		return err
+	}
+	return ErrInvalidCredentials
+}
+
+func (s *AuthService) Refresh(refreshToken string, client ClientInfo) (*LoginResult, error) {
+	token, err := s.refreshTokenRepo.FindByHash(hashToken(refreshToken))
+	if err != nil {
+		return nil, errors.New("invalid refresh token")
+	}
+
+	session := token.Session
+	if session == nil || session.User == nil || session.RevokedAt != nil {
+		return nil, errors.New("invalid refresh token")
+	}
+
+	if !session.User.IsActive() {
+		return nil, ErrAccountDeactivated
+	}
+
+	if token.UsedAt != nil {
+		s.sessionRepo.Revoke(session.ID)
+		return nil, errors.New("refresh token has already been used")
+	}
+
+	if time.Now().After(token.ExpiresAt) {
+		return nil, errors.New("refresh token expired")
+	}
+
+	marked, err := s.refreshTokenRepo.MarkUsed(token.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !marked {
+		s.sessionRepo.Revoke(session.ID)
+		return nil, errors.New("refresh token has already been used")
+	}
+
+	s.sessionRepo.Touch(session.ID, client.IPAddress, time.Now())
+
+	return s.issueTokens(session.User, session)
+}
+
+func (s *AuthService) Logout(sessionID uuid.UUID) error {
+	return s.sessionRepo.Revoke(sessionID)
+}
+
+func (s *AuthService) GetActiveSessions(userID uuid.UUID, query dto.PageQuery) ([]model.Session, *dto.Pagination, error) {
+	return s.sessionRepo.FindActiveByUserID(userID, time.Now(), query)
+}
+
+func (s *AuthService) TerminateSession(userID, sessionID uuid.UUID) error {
+	session, err := s.sessionRepo.FindByID(sessionID)
+	if err != nil || session.UserID != userID {
+		return errors.New("session not found")
+	}
+
+	if session.RevokedAt != nil {
+		return errors.New("session has already ended")
+	}
+
+	return s.sessionRepo.Revoke(sessionID)
+}
+
+func (s *AuthService) LogoutAll(userID uuid.UUID) error {
+	return s.sessionRepo.RevokeAllByUserID(userID)
+}
+
+func (s *AuthService) startSession(user *model.User, authMethod string, client ClientInfo) (*LoginResult, error) {
+	now := time.Now()
+	session := &model.Session{
+		UserID:     user.ID,
+		AuthMethod: authMethod,
+		UserAgent:  client.userAgent(),
+		IPAddress:  client.IPAddress,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(s.cfg.RefreshTokenTTL),
+	}
+
+	if err := s.sessionRepo.Create(session); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(user, session)
+}
+
+func (s *AuthService) Impersonate(impersonatorID, targetID uuid.UUID, client ClientInfo) (*LoginResult, error) {
+	if impersonatorID == targetID {
+		return nil, errors.New("cannot impersonate yourself")
+	}
+
+	impersonator, err := s.userRepo.FindByID(impersonatorID)
+	if err != nil || !s.canImpersonate(impersonator) {
+		return nil, errors.New("you are not allowed to impersonate users")
+	}
+
+	target, err := s.userRepo.FindByID(targetID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if target.ServiceAccount {
+		return nil, errors.New("service accounts cannot be impersonated")
+	}
+	if !target.IsActive() {
+		return nil, errors.New("cannot impersonate a deactivated user")
+	}
+	if target.Role == model.RoleAdmin || s.permissions.HasPermission(target.Role, model.PermissionUsersImpersonate) {
+		return nil, errors.New("admins and users who can impersonate cannot be impersonated")
+	}
+
+	now := time.Now()
+	session := &model.Session{
+		UserID:         target.ID,
+		ImpersonatorID: &impersonator.ID,
+		AuthMethod:     model.AuthMethodImpersonation,
+		UserAgent:      client.userAgent(),
+		IPAddress:      client.IPAddress,
+		LastSeenAt:     now,
+		ExpiresAt:      now.Add(s.cfg.ImpersonationTTL),
+	}
+
+	if err := s.sessionRepo.Create(session); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.generateToken(target, session, session.ExpiresAt)
+	if err != nil {
+		return nil, errors.New("failed to generate token")
+	}
+
+	return &LoginResult{
+		Token:        accessToken,
+		ExpiresAt:    &session.ExpiresAt,
+		User:         target,
+		Impersonator: impersonator,
+	}, nil
+}
+
+func (s *AuthService) issueTokens(user *model.User, session *model.Session) (*LoginResult, error) {
+	now := time.Now()
+	accessExpiresAt := now.Add(s.cfg.AccessTokenTTL)
+
+	accessToken, err := s.generateToken(user, session, accessExpiresAt)
+	if err != nil {
+		return nil, errors.New("failed to generate token")
+	}
+
+	refreshToken, err := generateRandomToken()
+	if err != nil {
+		return nil, errors.New("failed to generate token")
+	}
+
+	refreshExpiresAt := now.Add(s.cfg.RefreshTokenTTL)
+	if err := s.refreshTokenRepo.Create(&model.RefreshToken{
+		SessionID: session.ID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: refreshExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.UpdateExpiresAt(session.ID, refreshExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &LoginResult{
+		Token:                  accessToken,
+		RefreshToken:           refreshToken,
+		ExpiresAt:              &accessExpiresAt,
+		MFAEnrollmentRequired:  s.mfa.EnrollmentRequired(user),
+		PasswordChangeRequired: s.PasswordChangeRequired(user, session.AuthMethod),
+		User:                   user,
+	}, nil
+}
+
+func (s *AuthService) generateToken(user *model.User, session *model.Session, expiresAt time.Time) (string, error) {
 	claims := &Claims{
-		UserID: user.ID.String(),
-		Email:  user.Email,
-		Role:   user.Role,
+		UserID:       user.ID.String(),
+		Email:        user.Email,
+		Role:         user.Role,
+		SessionID:    session.ID.String(),
+		TokenVersion: user.TokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
+	if session.ImpersonatorID != nil {
+		claims.Impersonator = session.ImpersonatorID.String()
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.cfg.JWTSecret))
+	return s.signToken(claims)
 }
 
-func (s *AuthService) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.cfg.JWTSecret), nil
-	})
+func (s *AuthService) signToken(claims *Claims) (string, error) {
+	claims.Issuer = s.cfg.JWTIssuer
+	return s.signingKeys.Sign(claims)
+}
+
+func (s *AuthService) parseToken(tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, s.signingKeys.Keyfunc,
+		jwt.WithValidMethods([]string{model.SigningAlgorithmEdDSA, model.SigningAlgorithmRS256}),
+		jwt.WithIssuer(s.cfg.JWTIssuer),
+	)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
 	}
 
-	return nil, errors.New("invalid token")
+	return claims, nil
 }
 
-func (s *AuthService) CreateUser(req dto.CreateUserRequest) (*model.User, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+func generateRandomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *AuthService) ValidateToken(tokenString, ipAddress string) (*Claims, *model.Session, error) {
+	claims, err := s.parseToken(tokenString)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if claims.Purpose != "" {
+		return nil, nil, errors.New("invalid token")
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, nil, errors.New("invalid token")
+	}
+
+	session, err := s.sessionRepo.FindByID(sessionID)
+	if err != nil || session.RevokedAt != nil || session.User == nil {
+		return nil, nil, errors.New("session has been revoked")
+	}
+
+	if session.User.TokenVersion != claims.TokenVersion {
+		return nil, nil, errors.New("token has been revoked")
+	}
+
+	if !session.User.IsActive() {
+		return nil, nil, ErrAccountDeactivated
+	}
+
+	if session.ImpersonatorID != nil {
+		if session.Impersonator == nil || !s.canImpersonate(session.Impersonator) {
+			return nil, nil, errors.New("session has been revoked")
+		}
+		if time.Now().After(session.ExpiresAt) {
+			return nil, nil, errors.New("session has expired")
+		}
 	}
 
+	if now := time.Now(); now.Sub(session.LastSeenAt) > sessionLastSeenInterval || session.IPAddress != ipAddress {
+		s.sessionRepo.Touch(session.ID, ipAddress, now)
+	}
+
+	return claims, session, nil
+}
+
+func (s *AuthService) canImpersonate(user *model.User) bool {
+	return !user.ServiceAccount && user.IsActive() && s.permissions.HasPermission(user.Role, model.PermissionUsersImpersonate)
+}
+
+func (s *AuthService) invalidateTokens(user *model.User) error {
+	if err := s.userRepo.UpdateAndInvalidateTokens(user); err != nil {
+		return err
+	}
+	return s.sessionRepo.RevokeAllByUserID(user.ID)
+}
+
+func (s *AuthService) CreateUser(req dto.CreateUserRequest) (*model.User, error) {
 	user := &model.User{
-		Email:    req.Email,
-		Password: string(hashedPassword),
-		Name:     req.Name,
-		Role:     model.Role(req.Role),
+		Email:  req.Email,
+		Name:   req.Name,
+		Role:   model.Role(req.Role),
+		Status: model.UserStatusActive,
+
+		MustChangePassword: true,
+	}
+
+	hashedPassword, err := s.hashNewPassword(user, req.Password)
+	if err != nil {
+		return nil, err
 	}
+	user.Password = hashedPassword
 
 	if err := s.userRepo.Create(user); err != nil {
 		return nil, err
@@ -107,8 +520,31 @@ func (s *AuthService) CreateUser(req dto.CreateUserRequest) (*model.User, error)
 	return user, nil
 }
 
-func (s *AuthService) GetAllUsers() ([]model.User, error) {
-	return s.userRepo.FindAll()
+func (s *AuthService) SeedAdmin(email, password string) (bool, error) {
+	exists, err := s.userRepo.ExistsByEmail(email)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	_, err = s.CreateUser(dto.CreateUserRequest{
+		Email:    email,
+		Password: password,
+		Name:     "Admin",
+		Role:     string(model.RoleAdmin),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *AuthService) GetAllUsers(query dto.UserQuery) ([]model.User, *dto.Pagination, error) {
+	serviceAccount := false
+	query.ServiceAccount = &serviceAccount
+	return s.userRepo.FindAll(query)
 }
 
 func (s *AuthService) GetUserByID(id uuid.UUID) (*model.User, error) {
@@ -121,21 +557,65 @@ func (s *AuthService) UpdateUser(id uuid.UUID, req dto.UpdateUserRequest) (*mode
 		return nil, err
 	}
 
+	if user.ServiceAccount {
+		return nil, errors.New("service accounts cannot be edited as users")
+	}
+
+	revoke := false
+
 	if req.Email != "" {
 		user.Email = req.Email
 	}
 	if req.Name != "" {
 		user.Name = req.Name
 	}
-	if req.Role != "" {
+	if req.Role != "" && model.Role(req.Role) != user.Role {
+		if user.Role == model.RoleAdmin && user.IsActive() {
+			count, err := s.userRepo.CountActiveByRole(model.RoleAdmin)
+			if err != nil {
+				return nil, err
+			}
+			if count <= 1 {
+				return nil, errors.New("cannot change the role of the last active admin")
+			}
+		}
 		user.Role = model.Role(req.Role)
+		revoke = true
 	}
 	if req.Password != "" {
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		hashedPassword, err := s.hashNewPassword(user, req.Password)
 		if err != nil {
 			return nil, err
 		}
-		user.Password = string(hashedPassword)
+		if err := s.replacePassword(user, hashedPassword); err != nil {
+			return nil, err
+		}
+		user.MustChangePassword = true
+		revoke = true
+	}
+
+	if revoke {
+		if err := s.invalidateTokens(user); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *AuthService) UpdateProfile(id uuid.UUID, req dto.UpdateProfileRequest) (*model.User, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if req.Name != "" {
+		user.Name = req.Name
 	}
 
 	if err := s.userRepo.Update(user); err != nil {
@@ -145,25 +625,140 @@ func (s *AuthService) UpdateUser(id uuid.UUID, req dto.UpdateUserRequest) (*mode
 	return user, nil
 }
 
-func (s *AuthService) DeleteUser(id uuid.UUID) error {
-	return s.userRepo.Delete(id)
+func (s *AuthService) DeactivateUser(id, actorID uuid.UUID) (*model.User, error) {
+	if id == actorID {
+		return nil, errors.New("cannot deactivate your own account")
+	}
+
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if !user.IsActive() {
+		return nil, errors.New("user is already deactivated")
+	}
+
+	if user.Role == model.RoleAdmin {
+		count, err := s.userRepo.CountActiveByRole(model.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if count <= 1 {
+			return nil, errors.New("cannot deactivate the last active admin")
+		}
+	}
+
+	now := time.Now()
+	user.Status = model.UserStatusInactive
+	user.DeactivatedAt = &now
+	if err := s.invalidateTokens(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
 }
 
-func (s *AuthService) ChangePassword(id uuid.UUID, req dto.ChangePasswordRequest) error {
+func (s *AuthService) ReactivateUser(id uuid.UUID) (*model.User, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.IsActive() {
+		return nil, errors.New("user is already active")
+	}
+
+	user.Status = model.UserStatusActive
+	user.DeactivatedAt = nil
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *AuthService) UnlockUser(id uuid.UUID) error {
 	user, err := s.userRepo.FindByID(id)
 	if err != nil {
 		return errors.New("user not found")
 	}
+	return s.throttle.Reset(user.Email)
+}
+
+func (s *AuthService) ChangePassword(id, sessionID uuid.UUID, req dto.ChangePasswordRequest) (*LoginResult, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	session, err := s.sessionRepo.FindByID(sessionID)
+	if err != nil || session.UserID != user.ID || session.RevokedAt != nil {
+		return nil, errors.New("session has been revoked")
+	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
-		return errors.New("current password is incorrect")
+		return nil, errors.New("current password is incorrect")
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	hashedPassword, err := s.hashNewPassword(user, req.NewPassword)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	user.Password = string(hashedPassword)
-	return s.userRepo.Update(user)
+	if err := s.replacePassword(user, hashedPassword); err != nil {
+		return nil, err
+	}
+	user.MustChangePassword = false
+
+	if err := s.userRepo.UpdateAndInvalidateTokens(user); err != nil {
+		return nil, err
+	}
+	if err := s.sessionRepo.RevokeAllByUserIDExcept(user.ID, session.ID); err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepo.MarkUsedBySessionID(session.ID); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(user, session)
+}
+
+func (s *AuthService) hashNewPassword(user *model.User, newPassword string) (string, error) {
+	var previousHashes []string
+	if user.Password != "" {
+		previousHashes = append(previousHashes, user.Password)
+		if s.passwordPolicy.HistorySize > 1 {
+			histories, err := s.historyRepo.FindRecent(user.ID, s.passwordPolicy.HistorySize-1)
+			if err != nil {
+				return "", err
+			}
+			for _, h := range histories {
+				previousHashes = append(previousHashes, h.PasswordHash)
+			}
+		}
+	}
+
+	if err := s.passwordPolicy.Validate(newPassword, user.Email, previousHashes); err != nil {
+		return "", err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashedPassword), nil
+}
+
+func (s *AuthService) replacePassword(user *model.User, hashedPassword string) error {
+	if user.Password != "" && s.passwordPolicy.HistorySize > 1 {
+		if err := s.historyRepo.Add(&model.PasswordHistory{
+			UserID:       user.ID,
+			PasswordHash: user.Password,
+		}, s.passwordPolicy.HistorySize-1); err != nil {
+			return err
+		}
+	}
+	user.Password = hashedPassword
+	return nil
 }
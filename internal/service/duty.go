@@ -12,11 +12,13 @@ import (
 )
 
 type DutyService struct {
-	dutyRepo *repository.DutyRepository
+	dutyRepo     *repository.DutyRepository
+	userRepo     *repository.UserRepository
+	buildingRepo *repository.BuildingRepository
 }
 
-func NewDutyService(dutyRepo *repository.DutyRepository) *DutyService {
-	return &DutyService{dutyRepo: dutyRepo}
+func NewDutyService(dutyRepo *repository.DutyRepository, userRepo *repository.UserRepository, buildingRepo *repository.BuildingRepository) *DutyService {
+	return &DutyService{dutyRepo: dutyRepo, userRepo: userRepo, buildingRepo: buildingRepo}
 }
 
 func (s *DutyService) Create(req dto.CreateDutyRequest) (*model.Duty, error) {
@@ -36,15 +38,25 @@ func (s *DutyService) Create(req dto.CreateDutyRequest) (*model.Duty, error) {
 		if weekday < time.Monday || weekday > time.Thursday {
 			return nil, errors.New("NIGHT_STUDY duty is only for Monday to Thursday")
 		}
-		if req.Floor == nil {
+		if req.Floor == nil && req.FloorID == nil {
 			return nil, errors.New("floor is required for NIGHT_STUDY duty")
 		}
 	}
 
+	floorID, floor, err := s.resolveFloor(req.FloorID, req.Floor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkAssigneeActive(req.AssigneeID); err != nil {
+		return nil, err
+	}
+
 	duty := &model.Duty{
 		Type:       dutyType,
 		Date:       date,
-		Floor:      req.Floor,
+		Floor:      floor,
+		FloorID:    floorID,
 		AssigneeID: req.AssigneeID,
 	}
 
@@ -59,10 +71,16 @@ func (s *DutyService) GetByID(id uuid.UUID) (*model.Duty, error) {
 	return s.dutyRepo.FindByID(id)
 }
 
-func (s *DutyService) GetAll(query dto.DutyQuery) ([]model.Duty, error) {
+func (s *DutyService) GetAll(query dto.DutyQuery) ([]model.Duty, *dto.Pagination, error) {
 	return s.dutyRepo.FindAll(query)
 }
 
+func (s *DutyService) GetUpcomingForUser(userID uuid.UUID, limit int) ([]model.Duty, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return s.dutyRepo.FindUpcomingByAssignee(userID, today, limit)
+}
+
 func (s *DutyService) Update(id uuid.UUID, req dto.UpdateDutyRequest) (*model.Duty, error) {
 	duty, err := s.dutyRepo.FindByID(id)
 	if err != nil {
@@ -79,10 +97,18 @@ func (s *DutyService) Update(id uuid.UUID, req dto.UpdateDutyRequest) (*model.Du
 		}
 		duty.Date = date
 	}
-	if req.Floor != nil {
-		duty.Floor = req.Floor
+	if req.Floor != nil || req.FloorID != nil {
+		floorID, floor, err := s.resolveFloor(req.FloorID, req.Floor)
+		if err != nil {
+			return nil, err
+		}
+		duty.Floor = floor
+		duty.FloorID = floorID
 	}
-	if req.AssigneeID != uuid.Nil {
+	if req.AssigneeID != uuid.Nil && req.AssigneeID != duty.AssigneeID {
+		if err := s.checkAssigneeActive(req.AssigneeID); err != nil {
+			return nil, err
+		}
 		duty.AssigneeID = req.AssigneeID
 	}
 
@@ -112,6 +138,16 @@ func (s *DutyService) Generate(req dto.GenerateDutyRequest) ([]model.Duty, error
 		return nil, errors.New("start date must be before end date")
 	}
 
+	floorID, floor, err := s.resolveFloor(req.FloorID, req.Floor)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeIDs, err := s.activeAssigneeIDs(req.AssigneeIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	dutyType := model.DutyType(req.Type)
 	var duties []model.Duty
 	assigneeIdx := 0
@@ -130,8 +166,9 @@ func (s *DutyService) Generate(req dto.GenerateDutyRequest) ([]model.Duty, error
 			duty := model.Duty{
 				Type:       dutyType,
 				Date:       date,
-				Floor:      req.Floor,
-				AssigneeID: req.AssigneeIDs[assigneeIdx%len(req.AssigneeIDs)],
+				Floor:      floor,
+				FloorID:    floorID,
+				AssigneeID: assigneeIDs[assigneeIdx%len(assigneeIDs)],
 			}
 			duties = append(duties, duty)
 			assigneeIdx++
@@ -149,6 +186,71 @@ func (s *DutyService) Generate(req dto.GenerateDutyRequest) ([]model.Duty, error
 	return duties, nil
 }
 
+func (s *DutyService) resolveFloor(floorID *uuid.UUID, number *int) (*uuid.UUID, *int, error) {
+	if floorID != nil {
+		floor, err := s.buildingRepo.FindFloorByID(*floorID)
+		if err != nil {
+			return nil, nil, errors.New("floor not found")
+		}
+		if number != nil && *number != floor.Number {
+			return nil, nil, errors.New("floor does not match floor id")
+		}
+		return &floor.ID, &floor.Number, nil
+	}
+	if number == nil {
+		return nil, nil, nil
+	}
+
+	floors, err := s.buildingRepo.FindFloorsByNumber(*number)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch len(floors) {
+	case 0:
+		return nil, nil, errors.New("floor not found")
+	case 1:
+		return &floors[0].ID, &floors[0].Number, nil
+	default:
+		return nil, nil, errors.New("floor number exists in several buildings; specify a floor id")
+	}
+}
+
+func (s *DutyService) checkAssigneeActive(assigneeID uuid.UUID) error {
+	assignee, err := s.userRepo.FindByID(assigneeID)
+	if err != nil {
+		return errors.New("assignee not found")
+	}
+	if !assignee.IsActive() {
+		return errors.New("assignee is deactivated")
+	}
+	return nil
+}
+
+func (s *DutyService) activeAssigneeIDs(ids []uuid.UUID) ([]uuid.UUID, error) {
+	users, err := s.userRepo.FindActiveByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[uuid.UUID]bool, len(users))
+	for _, u := range users {
+		active[u.ID] = true
+	}
+
+	var result []uuid.UUID
+	for _, id := range ids {
+		if active[id] {
+			result = append(result, id)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, errors.New("no active assignees")
+	}
+
+	return result, nil
+}
+
 func (s *DutyService) SwapAssignees(duty1, duty2 *model.Duty) error {
 	duty1.AssigneeID, duty2.AssigneeID = duty2.AssigneeID, duty1.AssigneeID
 
@@ -224,8 +326,16 @@ func (s *DutySwapRequestService) GetPendingForUser(userID uuid.UUID) ([]model.Du
 	return s.swapRepo.FindPendingByTargetAssignee(userID)
 }
 
-func (s *DutySwapRequestService) GetMyRequests(userID uuid.UUID) ([]model.DutySwapRequest, error) {
-	return s.swapRepo.FindByRequester(userID)
+func (s *DutySwapRequestService) GetPendingPageForUser(userID uuid.UUID, query dto.PageQuery) ([]model.DutySwapRequest, *dto.Pagination, error) {
+	return s.swapRepo.FindPendingPageByTargetAssignee(userID, query)
+}
+
+func (s *DutySwapRequestService) GetMyRequests(userID uuid.UUID, query dto.PageQuery) ([]model.DutySwapRequest, *dto.Pagination, error) {
+	return s.swapRepo.FindByRequester(userID, query)
+}
+
+func (s *DutySwapRequestService) GetMyPendingRequests(userID uuid.UUID) ([]model.DutySwapRequest, error) {
+	return s.swapRepo.FindPendingByRequester(userID)
 }
 
 func (s *DutySwapRequestService) Approve(id, approverID uuid.UUID) error {
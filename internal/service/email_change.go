@@ -0,0 +1,152 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/mail"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type EmailChangeService struct {
+	userRepo   *repository.UserRepository
+	changeRepo *repository.EmailChangeTokenRepository
+	resetRepo  *repository.PasswordResetTokenRepository
+	mailer     mail.Mailer
+	cfg        *config.Config
+}
+
+func NewEmailChangeService(userRepo *repository.UserRepository, changeRepo *repository.EmailChangeTokenRepository, resetRepo *repository.PasswordResetTokenRepository, mailer mail.Mailer, cfg *config.Config) *EmailChangeService {
+	return &EmailChangeService{userRepo: userRepo, changeRepo: changeRepo, resetRepo: resetRepo, mailer: mailer, cfg: cfg}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (s *EmailChangeService) Request(userID uuid.UUID, req dto.EmailChangeRequest) (string, error) {
+	if s.mailer == nil {
+		return "", ErrMailDisabled
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return "", errors.New("user not found")
+	}
+	if user.ServiceAccount {
+		return "", errors.New("service accounts cannot change email")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return "", errors.New("current password is incorrect")
+	}
+
+	newEmail := normalizeEmail(req.NewEmail)
+	if newEmail == normalizeEmail(user.Email) {
+		return "", errors.New("new email is the same as the current email")
+	}
+	if existing, err := s.userRepo.FindByEmailIgnoreCase(newEmail); err == nil && existing.ID != user.ID {
+		return "", errors.New("email already in use")
+	}
+
+	if err := s.changeRepo.InvalidateByUserID(user.ID); err != nil {
+		return "", err
+	}
+
+	token, err := generateRandomToken()
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.changeRepo.Create(&model.EmailChangeToken{
+		UserID:    user.ID,
+		NewEmail:  newEmail,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(s.cfg.EmailChangeTTL),
+	}); err != nil {
+		return "", err
+	}
+
+	s.send(mail.Message{
+		To:      newEmail,
+		Subject: "[Dormi] 이메일 변경 확인",
+		Body: fmt.Sprintf(
+			"%s님, 안녕하세요.\n\n아래 링크를 열면 로그인 이메일이 %s(으)로 변경됩니다. 링크는 %d시간 동안 한 번만 사용할 수 있습니다.\n\n%s\n\n본인이 요청하지 않았다면 이 메일을 무시해 주세요.\n",
+			user.Name, newEmail, int(s.cfg.EmailChangeTTL.Hours()), s.confirmLink(token),
+		),
+	})
+	s.send(mail.Message{
+		To:      user.Email,
+		Subject: "[Dormi] 이메일 변경 요청 안내",
+		Body: fmt.Sprintf(
+			"%s님, 안녕하세요.\n\n계정 이메일을 %s(으)로 바꾸는 요청이 접수되었습니다. 새 주소에서 확인해야 변경이 완료됩니다.\n\n본인이 요청하지 않았다면 비밀번호를 바꾸고 관리자에게 알려 주세요.\n",
+			user.Name, newEmail,
+		),
+	})
+
+	return newEmail, nil
+}
+
+func (s *EmailChangeService) Confirm(token string) (*model.User, string, error) {
+	changeToken, err := s.changeRepo.FindByHash(hashToken(token))
+	if err != nil || changeToken.User == nil || !changeToken.User.IsActive() {
+		return nil, "", errors.New("invalid or expired email change token")
+	}
+
+	if changeToken.UsedAt != nil || time.Now().After(changeToken.ExpiresAt) {
+		return nil, "", errors.New("invalid or expired email change token")
+	}
+
+	user := changeToken.User
+	if existing, err := s.userRepo.FindByEmailIgnoreCase(changeToken.NewEmail); err == nil && existing.ID != user.ID {
+		return nil, "", errors.New("email already in use")
+	}
+
+	marked, err := s.changeRepo.MarkUsed(changeToken.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !marked {
+		return nil, "", errors.New("invalid or expired email change token")
+	}
+
+	oldEmail := user.Email
+	user.Email = changeToken.NewEmail
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, "", err
+	}
+	if err := s.resetRepo.InvalidateByUserID(user.ID); err != nil {
+		return nil, "", err
+	}
+
+	return user, oldEmail, nil
+}
+
+func (s *EmailChangeService) send(msg mail.Message) {
+	go func() {
+		if err := s.mailer.Send(msg); err != nil {
+			log.Printf("Failed to send email change mail to %s: %v", msg.To, err)
+		}
+	}()
+}
+
+func (s *EmailChangeService) confirmLink(token string) string {
+	u, err := url.Parse(s.cfg.EmailChangeURL)
+	if err != nil {
+		return s.cfg.EmailChangeURL + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"errors"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const maxGuardiansPerStudent = 5
+
+type GuardianService struct {
+	guardianRepo *repository.GuardianRepository
+	studentRepo  *repository.StudentRepository
+}
+
+func NewGuardianService(guardianRepo *repository.GuardianRepository, studentRepo *repository.StudentRepository) *GuardianService {
+	return &GuardianService{guardianRepo: guardianRepo, studentRepo: studentRepo}
+}
+
+func (s *GuardianService) GetByStudentID(studentID uuid.UUID) ([]model.Guardian, error) {
+	if _, err := s.studentRepo.FindByID(studentID); err != nil {
+		return nil, errors.New("student not found")
+	}
+	return s.guardianRepo.FindByStudentID(studentID)
+}
+
+func (s *GuardianService) Create(studentID uuid.UUID, req dto.GuardianRequest) (*model.Guardian, error) {
+	if _, err := s.studentRepo.FindByID(studentID); err != nil {
+		return nil, errors.New("student not found")
+	}
+
+	count, err := s.guardianRepo.CountByStudentID(studentID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxGuardiansPerStudent {
+		return nil, errors.New("too many guardians for this student")
+	}
+
+	if !phonePattern.MatchString(req.Phone) {
+		return nil, errors.New("invalid phone number")
+	}
+
+	guardian := &model.Guardian{ID: uuid.New(), StudentID: studentID}
+	applyGuardianRequest(guardian, req)
+
+	if err := s.guardianRepo.Save(guardian); err != nil {
+		return nil, err
+	}
+
+	return guardian, nil
+}
+
+func (s *GuardianService) Update(studentID, id uuid.UUID, req dto.GuardianRequest) (*model.Guardian, error) {
+	guardian, err := s.guardianRepo.FindByID(id)
+	if err != nil || guardian.StudentID != studentID {
+		return nil, errors.New("guardian not found")
+	}
+
+	if !phonePattern.MatchString(req.Phone) {
+		return nil, errors.New("invalid phone number")
+	}
+
+	applyGuardianRequest(guardian, req)
+
+	if err := s.guardianRepo.Save(guardian); err != nil {
+		return nil, err
+	}
+
+	return guardian, nil
+}
+
+func (s *GuardianService) Delete(studentID, id uuid.UUID) error {
+	guardian, err := s.guardianRepo.FindByID(id)
+	if err != nil || guardian.StudentID != studentID {
+		return errors.New("guardian not found")
+	}
+	return s.guardianRepo.Delete(id)
+}
+
+func applyGuardianRequest(guardian *model.Guardian, req dto.GuardianRequest) {
+	guardian.Name = req.Name
+	guardian.Relationship = req.Relationship
+	guardian.Phone = req.Phone
+	guardian.Email = req.Email
+	guardian.IsPrimary = req.IsPrimary
+	guardian.SMSConsent = req.SMSConsent
+	guardian.EmailConsent = req.EmailConsent
+	guardian.MedicalConsent = req.MedicalConsent
+}
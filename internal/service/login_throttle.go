@@ -0,0 +1,104 @@
+package service
+
+import (
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/repository"
+)
+
+const loginAttemptResetWindow = 24 * time.Hour
+
+type AccountLockedError struct {
+	Until     time.Time
+	Triggered bool
+}
+
+func (e *AccountLockedError) Error() string {
+	return "too many failed login attempts, try again later"
+}
+
+type IPThrottledError struct {
+	Until time.Time
+}
+
+func (e *IPThrottledError) Error() string {
+	return "too many failed login attempts from this address, try again later"
+}
+
+type LoginThrottleService struct {
+	attemptRepo *repository.LoginAttemptRepository
+	cfg         *config.Config
+}
+
+func NewLoginThrottleService(attemptRepo *repository.LoginAttemptRepository, cfg *config.Config) *LoginThrottleService {
+	return &LoginThrottleService{attemptRepo: attemptRepo, cfg: cfg}
+}
+
+func (s *LoginThrottleService) Check(email, ipAddress string) error {
+	now := time.Now()
+
+	if attempt, err := s.attemptRepo.FindByKey(ipKey(ipAddress)); err == nil {
+		if attempt.Failures >= s.cfg.LoginIPMaxFailures && now.Sub(attempt.LastFailureAt) <= loginAttemptResetWindow {
+			if until := attempt.LastFailureAt.Add(s.cfg.LoginIPDelay); until.After(now) {
+				return &IPThrottledError{Until: until}
+			}
+		}
+	}
+
+	attempt, err := s.attemptRepo.FindByKey(accountKey(email))
+	if err != nil {
+		return nil
+	}
+	if attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+		return &AccountLockedError{Until: *attempt.LockedUntil}
+	}
+	return nil
+}
+
+func (s *LoginThrottleService) RecordFailure(email, ipAddress string) error {
+	now := time.Now()
+	resetBefore := now.Add(-loginAttemptResetWindow)
+
+	s.attemptRepo.IncrementFailures(ipKey(ipAddress), now, resetBefore)
+
+	attempt, err := s.attemptRepo.IncrementFailures(accountKey(email), now, resetBefore)
+	if err != nil || attempt.Failures < s.cfg.LoginMaxFailures {
+		return nil
+	}
+
+	until := now.Add(s.lockoutDuration(attempt.Lockouts))
+	locked, err := s.attemptRepo.Lock(attempt.Key, s.cfg.LoginMaxFailures, until)
+	if err != nil || !locked {
+		return nil
+	}
+	return &AccountLockedError{Until: until, Triggered: true}
+}
+
+func (s *LoginThrottleService) RecordSuccess(email, ipAddress string) error {
+	s.attemptRepo.DecrementFailures(ipKey(ipAddress))
+	return s.attemptRepo.Delete(accountKey(email))
+}
+
+func (s *LoginThrottleService) Reset(email string) error {
+	return s.attemptRepo.Delete(accountKey(email))
+}
+
+func (s *LoginThrottleService) lockoutDuration(lockouts int) time.Duration {
+	d := s.cfg.LoginLockoutBase
+	for i := 0; i < lockouts && d < s.cfg.LoginLockoutMax; i++ {
+		d *= 2
+	}
+	if d > s.cfg.LoginLockoutMax {
+		d = s.cfg.LoginLockoutMax
+	}
+	return d
+}
+
+func accountKey(email string) string {
+	return "account:" + normalizeEmail(email)
+}
+
+func ipKey(ipAddress string) string {
+	return "ip:" + ipAddress
+}
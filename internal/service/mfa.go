@@ -0,0 +1,300 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	totpPeriod        = 30
+	totpDigits        = 6
+	totpSkew          = 1
+	recoveryCodeCount = 10
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type MFAService struct {
+	userRepo         *repository.UserRepository
+	recoveryCodeRepo *repository.RecoveryCodeRepository
+	cfg              *config.Config
+}
+
+func NewMFAService(userRepo *repository.UserRepository, recoveryCodeRepo *repository.RecoveryCodeRepository, cfg *config.Config) *MFAService {
+	return &MFAService{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo, cfg: cfg}
+}
+
+func (s *MFAService) Required(user *model.User) bool {
+	if user.ServiceAccount {
+		return false
+	}
+	for _, role := range s.cfg.MFARequiredRoles {
+		if model.Role(role) == user.Role {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MFAService) EnrollmentRequired(user *model.User) bool {
+	return !user.TOTPEnabled && s.Required(user)
+}
+
+func (s *MFAService) GetStatus(userID uuid.UUID) (*dto.MFAStatusResponse, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	remaining, err := s.recoveryCodeRepo.CountUnused(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MFAStatusResponse{
+		Enabled:                user.TOTPEnabled,
+		Required:               s.Required(user),
+		RemainingRecoveryCodes: remaining,
+	}, nil
+}
+
+func (s *MFAService) Setup(userID uuid.UUID) (*dto.MFASetupResponse, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.TOTPEnabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	secret := base32NoPadding.EncodeToString(b)
+
+	user.TOTPSecret = secret
+	user.TOTPLastCounter = 0
+	if err := s.userRepo.UpdateTOTP(user); err != nil {
+		return nil, err
+	}
+
+	return &dto.MFASetupResponse{
+		Secret:     secret,
+		OTPAuthURL: s.otpauthURL(user.Email, secret),
+	}, nil
+}
+
+func (s *MFAService) Enable(userID uuid.UUID, code string) ([]string, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.TOTPEnabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+	if user.TOTPSecret == "" {
+		return nil, errors.New("two-factor authentication setup has not been started")
+	}
+
+	if err := s.verifyTOTP(user, code); err != nil {
+		return nil, err
+	}
+
+	user.TOTPEnabled = true
+	if err := s.userRepo.UpdateTOTP(user); err != nil {
+		return nil, err
+	}
+
+	return s.generateRecoveryCodes(user.ID)
+}
+
+func (s *MFAService) Disable(userID uuid.UUID, code string) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if !user.TOTPEnabled {
+		return errors.New("two-factor authentication is not enabled")
+	}
+	if s.Required(user) {
+		return errors.New("two-factor authentication is required for your role")
+	}
+
+	if err := s.verifyTOTP(user, code); err != nil {
+		return err
+	}
+
+	return s.reset(user)
+}
+
+func (s *MFAService) Reset(userID uuid.UUID) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+	return s.reset(user)
+}
+
+func (s *MFAService) RegenerateRecoveryCodes(userID uuid.UUID, code string) ([]string, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if !user.TOTPEnabled {
+		return nil, errors.New("two-factor authentication is not enabled")
+	}
+
+	if err := s.verifyTOTP(user, code); err != nil {
+		return nil, err
+	}
+
+	return s.generateRecoveryCodes(user.ID)
+}
+
+func (s *MFAService) Verify(user *model.User, code, recoveryCode string) error {
+	if !user.TOTPEnabled {
+		return errors.New("two-factor authentication is not enabled")
+	}
+
+	if recoveryCode != "" {
+		stored, err := s.recoveryCodeRepo.FindUnused(user.ID, hashToken(normalizeRecoveryCode(recoveryCode)))
+		if err != nil {
+			return errors.New("invalid recovery code")
+		}
+		marked, err := s.recoveryCodeRepo.MarkUsed(stored.ID)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			return errors.New("invalid recovery code")
+		}
+		return nil
+	}
+
+	return s.verifyTOTP(user, code)
+}
+
+func (s *MFAService) reset(user *model.User) error {
+	user.TOTPEnabled = false
+	user.TOTPSecret = ""
+	user.TOTPLastCounter = 0
+	if err := s.userRepo.UpdateTOTP(user); err != nil {
+		return err
+	}
+	return s.recoveryCodeRepo.DeleteByUserID(user.ID)
+}
+
+func (s *MFAService) verifyTOTP(user *model.User, code string) error {
+	secret, err := base32NoPadding.DecodeString(user.TOTPSecret)
+	if err != nil {
+		return errors.New("invalid two-factor secret")
+	}
+
+	counter, ok := validateTOTP(secret, strings.TrimSpace(code), time.Now(), user.TOTPLastCounter)
+	if !ok {
+		return errors.New("invalid verification code")
+	}
+
+	advanced, err := s.userRepo.AdvanceTOTPCounter(user.ID, counter)
+	if err != nil {
+		return err
+	}
+	if !advanced {
+		return errors.New("invalid verification code")
+	}
+
+	user.TOTPLastCounter = counter
+	return nil
+}
+
+func (s *MFAService) generateRecoveryCodes(userID uuid.UUID) ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	records := make([]model.RecoveryCode, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		raw := strings.ToLower(base32NoPadding.EncodeToString(b))[:10]
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+		records = append(records, model.RecoveryCode{
+			UserID:   userID,
+			CodeHash: hashToken(raw),
+		})
+	}
+
+	if err := s.recoveryCodeRepo.ReplaceForUser(userID, records); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (s *MFAService) otpauthURL(email, secret string) string {
+	label := url.PathEscape(s.cfg.TOTPIssuer + ":" + email)
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", s.cfg.TOTPIssuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func validateTOTP(secret []byte, code string, now time.Time, lastCounter int64) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / totpPeriod
+	for offset := int64(-totpSkew); offset <= totpSkew; offset++ {
+		counter := current + offset
+		if counter <= lastCounter {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, counter)), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+func totpCode(secret []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
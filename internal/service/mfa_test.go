@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestTOTPCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tt := range tests {
+		if got := totpCode(rfc6238Secret, tt.unix/totpPeriod); got != tt.want {
+			t.Errorf("totpCode at %d: got %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := now.Unix() / totpPeriod
+	code := func(counter int64) string {
+		return totpCode(rfc6238Secret, counter)
+	}
+
+	tests := []struct {
+		name        string
+		code        string
+		lastCounter int64
+		wantCounter int64
+		wantOK      bool
+	}{
+		{name: "current window", code: code(current), wantCounter: current, wantOK: true},
+		{name: "previous window", code: code(current - 1), wantCounter: current - 1, wantOK: true},
+		{name: "next window", code: code(current + 1), wantCounter: current + 1, wantOK: true},
+		{name: "two windows ago", code: code(current - 2)},
+		{name: "two windows ahead", code: code(current + 2)},
+		{name: "already used", code: code(current), lastCounter: current},
+		{name: "older than last used", code: code(current - 1), lastCounter: current - 1},
+		{name: "newer than last used", code: code(current + 1), lastCounter: current, wantCounter: current + 1, wantOK: true},
+		{name: "wrong code", code: "000000"},
+		{name: "too short", code: code(current)[:5]},
+		{name: "too long", code: code(current) + "0"},
+		{name: "empty", code: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter, ok := validateTOTP(rfc6238Secret, tt.code, now, tt.lastCounter)
+			if ok != tt.wantOK || counter != tt.wantCounter {
+				t.Errorf("got (%d, %v), want (%d, %v)", counter, ok, tt.wantCounter, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{code: "abcde-fghij", want: "abcdefghij"},
+		{code: " ABCDE-FGHIJ ", want: "abcdefghij"},
+		{code: "abcde fghij", want: "abcdefghij"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeRecoveryCode(tt.code); got != tt.want {
+			t.Errorf("normalizeRecoveryCode(%q): got %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+)
+
+const oidcRequestTimeout = 10 * time.Second
+
+type oidcClient struct {
+	oauth    oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+type oidcAuthRequestStore interface {
+	Create(req *model.OIDCAuthRequest) error
+	FindByStateHash(hash string) (*model.OIDCAuthRequest, error)
+	MarkUsed(id uuid.UUID) (bool, error)
+	DeleteExpired(before time.Time) error
+}
+
+type oidcUserStore interface {
+	FindByEmailIgnoreCase(email string) (*model.User, error)
+}
+
+type identityLogin interface {
+	LoginWithIdentity(user *model.User, authMethod string, client ClientInfo) (*LoginResult, error)
+}
+
+type OIDCService struct {
+	userRepo        oidcUserStore
+	authRequestRepo oidcAuthRequestStore
+	authService     identityLogin
+	cfg             *config.Config
+
+	mu        sync.Mutex
+	clients   map[string]*oidcClient
+	discovery singleflight.Group
+}
+
+func NewOIDCService(userRepo *repository.UserRepository, authRequestRepo *repository.OIDCAuthRequestRepository, authService *AuthService, cfg *config.Config) *OIDCService {
+	return &OIDCService{
+		userRepo:        userRepo,
+		authRequestRepo: authRequestRepo,
+		authService:     authService,
+		cfg:             cfg,
+		clients:         make(map[string]*oidcClient),
+	}
+}
+
+func (s *OIDCService) Providers() []string {
+	names := []string{}
+	for _, p := range s.cfg.OIDCProviders {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func (s *OIDCService) AuthorizationURL(providerName string) (*dto.OIDCAuthorizeResponse, string, error) {
+	client, err := s.client(providerName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	state, err := generateRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	nonce, err := generateRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	binding, err := generateRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	s.authRequestRepo.DeleteExpired(time.Now())
+
+	if err := s.authRequestRepo.Create(&model.OIDCAuthRequest{
+		Provider:     providerName,
+		StateHash:    hashToken(state),
+		BindingHash:  hashToken(binding),
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		ExpiresAt:    time.Now().Add(s.cfg.OIDCAuthRequestTTL),
+	}); err != nil {
+		return nil, "", err
+	}
+
+	return &dto.OIDCAuthorizeResponse{
+		AuthorizationURL: client.oauth.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)),
+		State:            state,
+	}, binding, nil
+}
+
+func (s *OIDCService) Callback(providerName string, req dto.OIDCCallbackRequest, binding string, client ClientInfo) (*LoginResult, error) {
+	oidcClient, err := s.client(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	authRequest, err := s.authRequestRepo.FindByStateHash(hashToken(req.State))
+	if err != nil || authRequest.Provider != providerName {
+		return nil, errors.New("invalid or expired state")
+	}
+	if authRequest.UsedAt != nil || time.Now().After(authRequest.ExpiresAt) {
+		return nil, errors.New("invalid or expired state")
+	}
+	if binding == "" || subtle.ConstantTimeCompare([]byte(hashToken(binding)), []byte(authRequest.BindingHash)) != 1 {
+		return nil, errors.New("state was not issued to this browser")
+	}
+
+	marked, err := s.authRequestRepo.MarkUsed(authRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !marked {
+		return nil, errors.New("invalid or expired state")
+	}
+
+	ctx, cancel := context.WithTimeout(s.httpContext(), oidcRequestTimeout)
+	defer cancel()
+
+	token, err := oidcClient.oauth.Exchange(ctx, req.Code, oauth2.VerifierOption(authRequest.CodeVerifier))
+	if err != nil {
+		return nil, errors.New("failed to exchange authorization code")
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("id token missing from provider response")
+	}
+
+	idToken, err := oidcClient.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, errors.New("invalid id token")
+	}
+	if idToken.Nonce != authRequest.Nonce {
+		return nil, errors.New("invalid id token nonce")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.New("invalid id token claims")
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, errors.New("email is not verified by the identity provider")
+	}
+
+	provider := s.provider(providerName)
+	if !emailDomainAllowed(claims.Email, provider.AllowedDomains) {
+		return nil, errors.New("email domain is not allowed")
+	}
+
+	user, err := s.userRepo.FindByEmailIgnoreCase(claims.Email)
+	if err != nil {
+		return nil, errors.New("no account is linked to this email")
+	}
+
+	return s.authService.LoginWithIdentity(user, "oidc:"+providerName, client)
+}
+
+func (s *OIDCService) client(providerName string) (*oidcClient, error) {
+	provider := s.provider(providerName)
+	if provider == nil {
+		return nil, errors.New("unknown identity provider")
+	}
+
+	s.mu.Lock()
+	client, ok := s.clients[providerName]
+	s.mu.Unlock()
+	if ok {
+		return client, nil
+	}
+
+	v, err, _ := s.discovery.Do(providerName, func() (interface{}, error) {
+		discovered, err := oidc.NewProvider(s.httpContext(), provider.Issuer)
+		if err != nil {
+			return nil, errors.New("identity provider is unavailable")
+		}
+
+		client := &oidcClient{
+			oauth: oauth2.Config{
+				ClientID:     provider.ClientID,
+				ClientSecret: provider.ClientSecret,
+				RedirectURL:  provider.RedirectURL,
+				Endpoint:     discovered.Endpoint(),
+				Scopes:       provider.Scopes,
+			},
+			verifier: discovered.Verifier(&oidc.Config{ClientID: provider.ClientID}),
+		}
+
+		s.mu.Lock()
+		s.clients[providerName] = client
+		s.mu.Unlock()
+
+		return client, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*oidcClient), nil
+}
+
+func (s *OIDCService) provider(name string) *config.OIDCProvider {
+	for i := range s.cfg.OIDCProviders {
+		if s.cfg.OIDCProviders[i].Name == name {
+			return &s.cfg.OIDCProviders[i]
+		}
+	}
+	return nil
+}
+
+func (s *OIDCService) httpContext() context.Context {
+	return oidc.ClientContext(context.Background(), &http.Client{Timeout: oidcRequestTimeout})
+}
+
+func emailDomainAllowed(email string, allowedDomains []string) bool {
+	if len(allowedDomains) == 0 {
+		return true
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+
+	domain := strings.ToLower(email[at+1:])
+	for _, d := range allowedDomains {
+		if strings.ToLower(d) == domain {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,433 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+)
+
+const (
+	mockClientID     = "dormi"
+	mockClientSecret = "secret"
+	mockRedirectURL  = "https://dormi.test/api/auth/oidc/mock/callback"
+	mockKeyID        = "mock-key"
+)
+
+type mockGrant struct {
+	nonce     string
+	challenge string
+	claims    jwt.MapClaims
+}
+
+type mockIdP struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu               sync.Mutex
+	grants           map[string]mockGrant
+	discoveryFailure bool
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	idp := &mockIdP{t: t, key: key, grants: map[string]mockGrant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/jwks", idp.jwks)
+	mux.HandleFunc("/token", idp.token)
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (idp *mockIdP) discovery(w http.ResponseWriter, r *http.Request) {
+	idp.mu.Lock()
+	fail := idp.discoveryFailure
+	idp.mu.Unlock()
+	if fail {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	issuer := idp.server.URL
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/authorize",
+		"token_endpoint":                        issuer + "/token",
+		"jwks_uri":                              issuer + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (idp *mockIdP) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := idp.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": mockKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func (idp *mockIdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != mockClientID || clientSecret != mockClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	idp.mu.Lock()
+	grant, ok := idp.grants[r.PostForm.Get("code")]
+	delete(idp.grants, r.PostForm.Get("code"))
+	idp.mu.Unlock()
+
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("redirect_uri") != mockRedirectURL {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	if oauth2.S256ChallengeFromVerifier(r.PostForm.Get("code_verifier")) != grant.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            idp.server.URL,
+		"aud":            mockClientID,
+		"sub":            "mock-subject",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+		"nonce":          grant.nonce,
+		"email":          "kim@school.ac.kr",
+		"email_verified": true,
+	}
+	for k, v := range grant.claims {
+		claims[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = mockKeyID
+	idToken, err := token.SignedString(idp.key)
+	if err != nil {
+		idp.t.Error(err)
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": "mock-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     idToken,
+	})
+}
+
+func (idp *mockIdP) authorize(t *testing.T, authorizationURL string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	u, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(authorizationURL, idp.server.URL+"/authorize?") {
+		t.Fatalf("authorization URL %q does not point at the identity provider", authorizationURL)
+	}
+
+	q := u.Query()
+	if q.Get("client_id") != mockClientID || q.Get("redirect_uri") != mockRedirectURL || q.Get("response_type") != "code" {
+		t.Fatalf("unexpected authorization request: %s", u.RawQuery)
+	}
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" || q.Get("nonce") == "" {
+		t.Fatalf("authorization request is missing PKCE or nonce: %s", u.RawQuery)
+	}
+
+	code := uuid.NewString()
+	idp.mu.Lock()
+	idp.grants[code] = mockGrant{nonce: q.Get("nonce"), challenge: q.Get("code_challenge"), claims: claims}
+	idp.mu.Unlock()
+
+	return code
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+type fakeAuthRequests struct {
+	byState map[string]*model.OIDCAuthRequest
+}
+
+func (f *fakeAuthRequests) Create(req *model.OIDCAuthRequest) error {
+	req.ID = uuid.New()
+	stored := *req
+	f.byState[req.StateHash] = &stored
+	return nil
+}
+
+func (f *fakeAuthRequests) FindByStateHash(hash string) (*model.OIDCAuthRequest, error) {
+	req, ok := f.byState[hash]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	found := *req
+	return &found, nil
+}
+
+func (f *fakeAuthRequests) MarkUsed(id uuid.UUID) (bool, error) {
+	for _, req := range f.byState {
+		if req.ID == id && req.UsedAt == nil {
+			now := time.Now()
+			req.UsedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeAuthRequests) DeleteExpired(before time.Time) error {
+	for hash, req := range f.byState {
+		if req.ExpiresAt.Before(before) {
+			delete(f.byState, hash)
+		}
+	}
+	return nil
+}
+
+type fakeUsers map[string]*model.User
+
+func (f fakeUsers) FindByEmailIgnoreCase(email string) (*model.User, error) {
+	user, ok := f[strings.ToLower(email)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+type fakeIdentityLogin struct {
+	user       *model.User
+	authMethod string
+}
+
+func (f *fakeIdentityLogin) LoginWithIdentity(user *model.User, authMethod string, client ClientInfo) (*LoginResult, error) {
+	f.user = user
+	f.authMethod = authMethod
+	return &LoginResult{Token: "access-token", User: user}, nil
+}
+
+func newTestOIDCService(idp *mockIdP, users fakeUsers) (*OIDCService, *fakeAuthRequests, *fakeIdentityLogin) {
+	requests := &fakeAuthRequests{byState: map[string]*model.OIDCAuthRequest{}}
+	login := &fakeIdentityLogin{}
+	cfg := &config.Config{
+		OIDCProviders: []config.OIDCProvider{{
+			Name:           "mock",
+			Issuer:         idp.server.URL,
+			ClientID:       mockClientID,
+			ClientSecret:   mockClientSecret,
+			RedirectURL:    mockRedirectURL,
+			Scopes:         []string{"openid", "email"},
+			AllowedDomains: []string{"school.ac.kr"},
+		}},
+		OIDCAuthRequestTTL: time.Minute,
+	}
+
+	s := &OIDCService{
+		userRepo:        users,
+		authRequestRepo: requests,
+		authService:     login,
+		cfg:             cfg,
+		clients:         make(map[string]*oidcClient),
+	}
+	return s, requests, login
+}
+
+func TestOIDCCallback(t *testing.T) {
+	idp := newMockIdP(t)
+	users := fakeUsers{
+		"kim@school.ac.kr": {ID: uuid.New(), Email: "kim@school.ac.kr"},
+		"kim@gmail.com":    {ID: uuid.New(), Email: "kim@gmail.com"},
+	}
+
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		tamper   func(req *dto.OIDCCallbackRequest, binding *string, stored *model.OIDCAuthRequest)
+		wantUser string
+		wantErr  string
+	}{
+		{name: "verified staff email", wantUser: "kim@school.ac.kr"},
+		{name: "email case differs", claims: jwt.MapClaims{"email": "Kim@School.AC.KR"}, wantUser: "kim@school.ac.kr"},
+		{
+			name: "state mismatch",
+			tamper: func(req *dto.OIDCCallbackRequest, binding *string, stored *model.OIDCAuthRequest) {
+				req.State = "forged-state"
+			},
+			wantErr: "invalid or expired state",
+		},
+		{
+			name: "expired state",
+			tamper: func(req *dto.OIDCCallbackRequest, binding *string, stored *model.OIDCAuthRequest) {
+				stored.ExpiresAt = time.Now().Add(-time.Second)
+			},
+			wantErr: "invalid or expired state",
+		},
+		{
+			name: "state from another browser",
+			tamper: func(req *dto.OIDCCallbackRequest, binding *string, stored *model.OIDCAuthRequest) {
+				*binding = "another-browser"
+			},
+			wantErr: "state was not issued to this browser",
+		},
+		{
+			name: "missing browser binding",
+			tamper: func(req *dto.OIDCCallbackRequest, binding *string, stored *model.OIDCAuthRequest) {
+				*binding = ""
+			},
+			wantErr: "state was not issued to this browser",
+		},
+		{name: "nonce mismatch", claims: jwt.MapClaims{"nonce": "replayed-nonce"}, wantErr: "invalid id token nonce"},
+		{
+			name: "pkce verifier mismatch",
+			tamper: func(req *dto.OIDCCallbackRequest, binding *string, stored *model.OIDCAuthRequest) {
+				stored.CodeVerifier = oauth2.GenerateVerifier()
+			},
+			wantErr: "failed to exchange authorization code",
+		},
+		{name: "id token for another client", claims: jwt.MapClaims{"aud": "other-client"}, wantErr: "invalid id token"},
+		{name: "email not verified", claims: jwt.MapClaims{"email_verified": false}, wantErr: "email is not verified by the identity provider"},
+		{name: "email missing", claims: jwt.MapClaims{"email": ""}, wantErr: "email is not verified by the identity provider"},
+		{name: "disallowed domain", claims: jwt.MapClaims{"email": "kim@gmail.com"}, wantErr: "email domain is not allowed"},
+		{name: "unknown email", claims: jwt.MapClaims{"email": "lee@school.ac.kr"}, wantErr: "no account is linked to this email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, requests, login := newTestOIDCService(idp, users)
+
+			authorize, binding, err := s.AuthorizationURL("mock")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := dto.OIDCCallbackRequest{
+				Code:  idp.authorize(t, authorize.AuthorizationURL, tt.claims),
+				State: authorize.State,
+			}
+			if tt.tamper != nil {
+				tt.tamper(&req, &binding, requests.byState[hashToken(authorize.State)])
+			}
+
+			result, err := s.Callback("mock", req, binding, ClientInfo{})
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				if login.user != nil {
+					t.Fatalf("signed in %s despite the error", login.user.Email)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.User.Email != tt.wantUser || login.authMethod != "oidc:mock" {
+				t.Errorf("signed in %s via %s, want %s via oidc:mock", result.User.Email, login.authMethod, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestOIDCCallbackRejectsReusedState(t *testing.T) {
+	idp := newMockIdP(t)
+	s, _, _ := newTestOIDCService(idp, fakeUsers{"kim@school.ac.kr": {ID: uuid.New(), Email: "kim@school.ac.kr"}})
+
+	authorize, binding, err := s.AuthorizationURL("mock")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := dto.OIDCCallbackRequest{Code: idp.authorize(t, authorize.AuthorizationURL, nil), State: authorize.State}
+	if _, err := s.Callback("mock", req, binding, ClientInfo{}); err != nil {
+		t.Fatal(err)
+	}
+
+	req.Code = idp.authorize(t, authorize.AuthorizationURL, nil)
+	if _, err := s.Callback("mock", req, binding, ClientInfo{}); err == nil || err.Error() != "invalid or expired state" {
+		t.Fatalf("got error %v, want the reused state to be rejected", err)
+	}
+}
+
+func TestOIDCDiscoveryFailureIsRetried(t *testing.T) {
+	idp := newMockIdP(t)
+	s, _, _ := newTestOIDCService(idp, fakeUsers{})
+
+	idp.mu.Lock()
+	idp.discoveryFailure = true
+	idp.mu.Unlock()
+
+	if _, _, err := s.AuthorizationURL("mock"); err == nil {
+		t.Fatal("expected an error while the identity provider is unavailable")
+	}
+
+	idp.mu.Lock()
+	idp.discoveryFailure = false
+	idp.mu.Unlock()
+
+	if _, _, err := s.AuthorizationURL("mock"); err != nil {
+		t.Fatalf("discovery failure was cached: %v", err)
+	}
+}
+
+func TestOIDCUnknownProvider(t *testing.T) {
+	idp := newMockIdP(t)
+	s, _, _ := newTestOIDCService(idp, fakeUsers{})
+
+	if _, _, err := s.AuthorizationURL("other"); err == nil || err.Error() != "unknown identity provider" {
+		t.Fatalf("got error %v, want unknown identity provider", err)
+	}
+
+	_, err := s.Callback("other", dto.OIDCCallbackRequest{Code: "code", State: "state"}, "binding", ClientInfo{})
+	if err == nil || err.Error() != "unknown identity provider" {
+		t.Fatalf("got error %v, want unknown identity provider", err)
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/mail"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+)
+
+const passwordResetRequestInterval = time.Minute
+
+var ErrMailDisabled = errors.New("mail delivery is not configured")
+
+type PasswordResetService struct {
+	userRepo    *repository.UserRepository
+	resetRepo   *repository.PasswordResetTokenRepository
+	authService *AuthService
+	throttle    *LoginThrottleService
+	mailer      mail.Mailer
+	cfg         *config.Config
+}
+
+func NewPasswordResetService(userRepo *repository.UserRepository, resetRepo *repository.PasswordResetTokenRepository, authService *AuthService, throttle *LoginThrottleService, mailer mail.Mailer, cfg *config.Config) *PasswordResetService {
+	return &PasswordResetService{userRepo: userRepo, resetRepo: resetRepo, authService: authService, throttle: throttle, mailer: mailer, cfg: cfg}
+}
+
+func (s *PasswordResetService) Request(email string) error {
+	if s.mailer == nil {
+		return ErrMailDisabled
+	}
+
+	user, err := s.userRepo.FindByEmail(email)
+	if err != nil || !user.IsActive() || user.ServiceAccount {
+		return nil
+	}
+
+	recent, err := s.resetRepo.ExistsCreatedSince(user.ID, time.Now().Add(-passwordResetRequestInterval))
+	if err != nil {
+		return err
+	}
+	if recent {
+		return nil
+	}
+
+	if err := s.resetRepo.InvalidateByUserID(user.ID); err != nil {
+		return err
+	}
+
+	token, err := generateRandomToken()
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(s.cfg.PasswordResetTTL)
+	if err := s.resetRepo.Create(&model.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return err
+	}
+
+	msg := mail.Message{
+		To:      user.Email,
+		Subject: "[Dormi] 비밀번호 재설정 안내",
+		Body: fmt.Sprintf(
+			"%s님, 안녕하세요.\n\n아래 링크에서 비밀번호를 재설정할 수 있습니다. 링크는 %d분 동안 한 번만 사용할 수 있습니다.\n\n%s\n\n본인이 요청하지 않았다면 이 메일을 무시해 주세요.\n",
+			user.Name, int(s.cfg.PasswordResetTTL.Minutes()), s.resetLink(token),
+		),
+	}
+
+	go func() {
+		if err := s.mailer.Send(msg); err != nil {
+			log.Printf("Failed to send password reset mail to %s: %v", msg.To, err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *PasswordResetService) Confirm(token, newPassword string) (*model.User, error) {
+	resetToken, err := s.resetRepo.FindByHash(hashToken(token))
+	if err != nil || resetToken.User == nil || !resetToken.User.IsActive() {
+		return nil, errors.New("invalid or expired reset token")
+	}
+
+	if resetToken.UsedAt != nil || time.Now().After(resetToken.ExpiresAt) {
+		return nil, errors.New("invalid or expired reset token")
+	}
+
+	user := resetToken.User
+	hashedPassword, err := s.authService.hashNewPassword(user, newPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	marked, err := s.resetRepo.MarkUsed(resetToken.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !marked {
+		return nil, errors.New("invalid or expired reset token")
+	}
+
+	if err := s.authService.replacePassword(user, hashedPassword); err != nil {
+		return nil, err
+	}
+	user.MustChangePassword = false
+	if err := s.authService.invalidateTokens(user); err != nil {
+		return nil, err
+	}
+
+	s.throttle.Reset(user.Email)
+
+	return user, nil
+}
+
+func (s *PasswordResetService) resetLink(token string) string {
+	u, err := url.Parse(s.cfg.PasswordResetURL)
+	if err != nil {
+		return s.cfg.PasswordResetURL + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
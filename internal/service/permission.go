@@ -0,0 +1,172 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+)
+
+const permissionCacheTTL = 30 * time.Second
+
+type PermissionService struct {
+	permissionRepo *repository.PermissionRepository
+
+	mu       sync.RWMutex
+	grants   map[model.Role]map[model.Permission]bool
+	loadedAt time.Time
+}
+
+func NewPermissionService(permissionRepo *repository.PermissionRepository) *PermissionService {
+	return &PermissionService{permissionRepo: permissionRepo}
+}
+
+func (s *PermissionService) SyncDefaults() error {
+	seeded, err := s.permissionRepo.FindSeeded()
+	if err != nil {
+		return err
+	}
+
+	known := make(map[model.Permission]bool, len(seeded))
+	for _, sp := range seeded {
+		known[sp.Permission] = true
+	}
+
+	for _, p := range model.AllPermissions {
+		if known[p] {
+			continue
+		}
+		if err := s.permissionRepo.Seed(p, model.DefaultPermissionRoles[p]); err != nil {
+			return err
+		}
+	}
+
+	return s.reload()
+}
+
+func (s *PermissionService) HasPermission(role model.Role, permission model.Permission) bool {
+	s.reloadIfStale()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.grants[role][permission]
+}
+
+func (s *PermissionService) GetPermissionsForRole(role model.Role) []model.Permission {
+	s.reloadIfStale()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	permissions := []model.Permission{}
+	for p := range s.grants[role] {
+		permissions = append(permissions, p)
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i] < permissions[j] })
+	return permissions
+}
+
+func (s *PermissionService) GetRolePermissions() (map[model.Role][]model.Permission, error) {
+	grants, err := s.permissionRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[model.Role][]model.Permission, len(model.AllRoles))
+	for _, role := range model.AllRoles {
+		result[role] = []model.Permission{}
+	}
+	for _, g := range grants {
+		result[g.Role] = append(result[g.Role], g.Permission)
+	}
+	return result, nil
+}
+
+func (s *PermissionService) UpdateRolePermissions(role model.Role, permissions []string) ([]model.Permission, error) {
+	if !isKnownRole(role) {
+		return nil, errors.New("invalid role")
+	}
+
+	seen := make(map[model.Permission]bool)
+	var result []model.Permission
+	for _, name := range permissions {
+		p := model.Permission(name)
+		if !isKnownPermission(p) {
+			return nil, fmt.Errorf("unknown permission: %s", name)
+		}
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		result = append(result, p)
+	}
+
+	if role == model.RoleAdmin && !seen[model.PermissionPermissionsManage] {
+		return nil, fmt.Errorf("%s cannot remove %s", model.RoleAdmin, model.PermissionPermissionsManage)
+	}
+
+	if err := s.permissionRepo.ReplaceForRole(role, result); err != nil {
+		return nil, err
+	}
+
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
+
+func (s *PermissionService) reloadIfStale() {
+	s.mu.RLock()
+	stale := time.Since(s.loadedAt) > permissionCacheTTL
+	s.mu.RUnlock()
+
+	if stale {
+		s.reload()
+	}
+}
+
+func (s *PermissionService) reload() error {
+	grants, err := s.permissionRepo.FindAll()
+	if err != nil {
+		return err
+	}
+
+	m := make(map[model.Role]map[model.Permission]bool)
+	for _, g := range grants {
+		if m[g.Role] == nil {
+			m[g.Role] = make(map[model.Permission]bool)
+		}
+		m[g.Role][g.Permission] = true
+	}
+
+	s.mu.Lock()
+	s.grants = m
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+
+	return nil
+}
+
+func isKnownRole(role model.Role) bool {
+	for _, r := range model.AllRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func isKnownPermission(permission model.Permission) bool {
+	for _, p := range model.AllPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -71,12 +71,12 @@ func (s *PointService) BulkGivePoints(req dto.BulkGivePointRequest, givenBy uuid
 	return points, nil
 }
 
-func (s *PointService) GetAll(query dto.PointQuery) ([]model.Point, error) {
+func (s *PointService) GetAll(query dto.PointQuery) ([]model.Point, *dto.Pagination, error) {
 	return s.pointRepo.FindAll(query)
 }
 
-func (s *PointService) GetByStudentID(studentID uuid.UUID) ([]model.Point, error) {
-	return s.pointRepo.FindByStudentID(studentID)
+func (s *PointService) GetByStudentID(studentID uuid.UUID, page dto.PageQuery) ([]model.Point, *dto.Pagination, error) {
+	return s.pointRepo.FindByStudentID(studentID, page)
 }
 
 func (s *PointService) GetSummary(studentID uuid.UUID) (*dto.PointSummary, error) {
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type PointProposalService struct {
+	proposalRepo *repository.PointProposalRepository
+	studentRepo  *repository.StudentRepository
+	reasonRepo   *repository.PointReasonRepository
+	cfg          *config.Config
+}
+
+func NewPointProposalService(proposalRepo *repository.PointProposalRepository, studentRepo *repository.StudentRepository, reasonRepo *repository.PointReasonRepository, cfg *config.Config) *PointProposalService {
+	return &PointProposalService{proposalRepo: proposalRepo, studentRepo: studentRepo, reasonRepo: reasonRepo, cfg: cfg}
+}
+
+func (s *PointProposalService) Create(req dto.CreatePointProposalRequest, proposedBy uuid.UUID) (*model.PointProposal, error) {
+	reason, err := s.reasonRepo.FindByID(req.ReasonID)
+	if err != nil {
+		return nil, errors.New("invalid reason")
+	}
+	if !reason.CouncilAllowed {
+		return nil, errors.New("this reason cannot be proposed by council members")
+	}
+
+	if _, err := s.studentRepo.FindByID(req.StudentID); err != nil {
+		return nil, errors.New("student not found")
+	}
+
+	proposal := &model.PointProposal{
+		StudentID:  req.StudentID,
+		ReasonID:   req.ReasonID,
+		ProposedBy: proposedBy,
+		Note:       req.Note,
+		Status:     model.PointProposalStatusPending,
+	}
+
+	now := time.Now()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if err := s.proposalRepo.CreateWithinDailyLimit(proposal, startOfDay, s.cfg.CouncilDailyProposalLimit); err != nil {
+		return nil, err
+	}
+
+	return s.proposalRepo.FindByID(proposal.ID)
+}
+
+func (s *PointProposalService) GetAll(query dto.PointProposalQuery) ([]model.PointProposal, *dto.Pagination, error) {
+	return s.proposalRepo.FindAll(query)
+}
+
+func (s *PointProposalService) GetMine(userID uuid.UUID, page dto.PageQuery) ([]model.PointProposal, *dto.Pagination, error) {
+	return s.proposalRepo.FindAll(dto.PointProposalQuery{ProposedBy: userID, PageQuery: page})
+}
+
+func (s *PointProposalService) Approve(id, reviewerID uuid.UUID) (*model.PointProposal, error) {
+	proposal, err := s.proposalRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("proposal not found")
+	}
+
+	if proposal.Status != model.PointProposalStatusPending {
+		return nil, errors.New("proposal is not pending")
+	}
+
+	if proposal.ProposedBy == reviewerID {
+		return nil, errors.New("cannot review your own proposal")
+	}
+
+	if _, err := s.studentRepo.FindByID(proposal.StudentID); err != nil {
+		return nil, errors.New("student not found")
+	}
+
+	point := &model.Point{
+		StudentID:  proposal.StudentID,
+		ReasonID:   proposal.ReasonID,
+		GivenBy:    reviewerID,
+		GivenAt:    time.Now(),
+		ProposedBy: &proposal.ProposedBy,
+		ProposalID: &proposal.ID,
+	}
+
+	if err := s.proposalRepo.Approve(proposal, point, s.cfg.CouncilDailyProposalLimit); err != nil {
+		return nil, err
+	}
+
+	return s.proposalRepo.FindByID(id)
+}
+
+func (s *PointProposalService) Reject(id, reviewerID uuid.UUID, comment string) (*model.PointProposal, error) {
+	proposal, err := s.proposalRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("proposal not found")
+	}
+
+	if proposal.Status != model.PointProposalStatusPending {
+		return nil, errors.New("proposal is not pending")
+	}
+
+	if proposal.ProposedBy == reviewerID {
+		return nil, errors.New("cannot review your own proposal")
+	}
+
+	if err := s.proposalRepo.Reject(id, reviewerID, comment); err != nil {
+		return nil, err
+	}
+
+	return s.proposalRepo.FindByID(id)
+}
@@ -18,9 +18,10 @@ func NewPointReasonService(reasonRepo *repository.PointReasonRepository) *PointR
 
 func (s *PointReasonService) Create(req dto.CreatePointReasonRequest) (*model.PointReason, error) {
 	reason := &model.PointReason{
-		Name:  req.Name,
-		Type:  model.PointType(req.Type),
-		Score: req.Score,
+		Name:           req.Name,
+		Type:           model.PointType(req.Type),
+		Score:          req.Score,
+		CouncilAllowed: req.CouncilAllowed,
 	}
 
 	if err := s.reasonRepo.Create(reason); err != nil {
@@ -34,12 +35,8 @@ func (s *PointReasonService) GetByID(id uuid.UUID) (*model.PointReason, error) {
 	return s.reasonRepo.FindByID(id)
 }
 
-func (s *PointReasonService) GetAll() ([]model.PointReason, error) {
-	return s.reasonRepo.FindAll()
-}
-
-func (s *PointReasonService) GetByType(pointType string) ([]model.PointReason, error) {
-	return s.reasonRepo.FindByType(model.PointType(pointType))
+func (s *PointReasonService) GetAll(query dto.PointReasonQuery) ([]model.PointReason, *dto.Pagination, error) {
+	return s.reasonRepo.FindAll(query)
 }
 
 func (s *PointReasonService) Update(id uuid.UUID, req dto.UpdatePointReasonRequest) (*model.PointReason, error) {
@@ -57,6 +54,9 @@ func (s *PointReasonService) Update(id uuid.UUID, req dto.UpdatePointReasonReque
 	if req.Score > 0 {
 		reason.Score = req.Score
 	}
+	if req.CouncilAllowed != nil {
+		reason.CouncilAllowed = *req.CouncilAllowed
+	}
 
 	if err := s.reasonRepo.Update(reason); err != nil {
 		return nil, err
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type RolloverService struct {
+	studentRepo *repository.StudentRepository
+	pointRepo   *repository.PointRepository
+}
+
+func NewRolloverService(studentRepo *repository.StudentRepository, pointRepo *repository.PointRepository) *RolloverService {
+	return &RolloverService{studentRepo: studentRepo, pointRepo: pointRepo}
+}
+
+func (s *RolloverService) Preview() (*dto.RolloverResponse, error) {
+	plan, _, _, err := s.plan()
+	return plan, err
+}
+
+func (s *RolloverService) Commit(req dto.RolloverRequest) (*dto.RolloverResponse, error) {
+	plan, promotions, graduates, err := s.plan()
+	if err != nil {
+		return nil, err
+	}
+	if plan.Checksum != req.Checksum {
+		return nil, errors.New("students have changed since the preview")
+	}
+	if len(promotions) == 0 && len(graduates) == 0 {
+		return nil, errors.New("no students to roll over")
+	}
+
+	archived, err := s.studentRepo.Rollover(promotions, graduates, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	plan.ArchivedPoints = archived
+
+	return plan, nil
+}
+
+func (s *RolloverService) plan() (*dto.RolloverResponse, map[int][]uuid.UUID, []uuid.UUID, error) {
+	students, err := s.studentRepo.FindAll(dto.StudentQuery{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	plan := &dto.RolloverResponse{
+		Promoted:  []dto.RolloverStudentResponse{},
+		Graduated: []dto.RolloverStudentResponse{},
+	}
+	promotions := map[int][]uuid.UUID{}
+	var graduates []uuid.UUID
+
+	for _, student := range students {
+		entry := dto.RolloverStudentResponse{
+			StudentID:     student.ID,
+			StudentNumber: student.StudentNumber,
+			Name:          student.Name,
+			RoomNumber:    student.RoomNumber,
+			FromGrade:     student.Grade,
+		}
+		switch {
+		case student.Grade == model.FinalGrade:
+			plan.Graduated = append(plan.Graduated, entry)
+			graduates = append(graduates, student.ID)
+		case student.Grade >= 1 && student.Grade < model.FinalGrade:
+			entry.ToGrade = student.Grade + 1
+			plan.Promoted = append(plan.Promoted, entry)
+			promotions[student.Grade] = append(promotions[student.Grade], student.ID)
+		}
+	}
+
+	plan.ArchivedPoints, err = s.pointRepo.CountUnarchived(graduates)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	plan.Checksum = rolloverChecksum(students)
+
+	return plan, promotions, graduates, nil
+}
+
+func rolloverChecksum(students []model.Student) string {
+	sorted := make([]model.Student, len(students))
+	copy(sorted, students)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID.String() < sorted[j].ID.String()
+	})
+
+	h := sha256.New()
+	for _, s := range sorted {
+		fmt.Fprintf(h, "%s:%d:%d\n", s.ID, s.Grade, s.UpdatedAt.UnixNano())
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
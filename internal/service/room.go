@@ -0,0 +1,395 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type RoomService struct {
+	buildingRepo   *repository.BuildingRepository
+	roomRepo       *repository.RoomRepository
+	assignmentRepo *repository.RoomAssignmentRepository
+}
+
+func NewRoomService(buildingRepo *repository.BuildingRepository, roomRepo *repository.RoomRepository, assignmentRepo *repository.RoomAssignmentRepository) *RoomService {
+	return &RoomService{buildingRepo: buildingRepo, roomRepo: roomRepo, assignmentRepo: assignmentRepo}
+}
+
+type RoomOccupancy struct {
+	Room      model.Room
+	Occupants map[uuid.UUID]model.Student
+}
+
+func (r *RoomOccupancy) Vacancies() int {
+	return len(r.Room.Beds) - len(r.Occupants)
+}
+
+func (s *RoomService) CreateBuilding(req dto.BuildingRequest) (*model.Building, error) {
+	building := &model.Building{Name: req.Name}
+	if err := s.buildingRepo.Create(building); err != nil {
+		return nil, err
+	}
+	return building, nil
+}
+
+func (s *RoomService) GetBuildings(query dto.PageQuery) ([]model.Building, *dto.Pagination, error) {
+	return s.buildingRepo.FindPage(query)
+}
+
+func (s *RoomService) UpdateBuilding(id uuid.UUID, req dto.BuildingRequest) (*model.Building, error) {
+	building, err := s.buildingRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("building not found")
+	}
+
+	building.Name = req.Name
+	if err := s.buildingRepo.Update(building); err != nil {
+		return nil, err
+	}
+
+	return building, nil
+}
+
+func (s *RoomService) DeleteBuilding(id uuid.UUID) error {
+	if _, err := s.buildingRepo.FindByID(id); err != nil {
+		return errors.New("building not found")
+	}
+
+	count, err := s.buildingRepo.CountFloors(id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("building still has floors")
+	}
+
+	return s.buildingRepo.Delete(id)
+}
+
+func (s *RoomService) CreateFloor(buildingID uuid.UUID, req dto.CreateFloorRequest) (*model.Floor, error) {
+	if _, err := s.buildingRepo.FindByID(buildingID); err != nil {
+		return nil, errors.New("building not found")
+	}
+
+	floor := &model.Floor{BuildingID: buildingID, Number: req.Number}
+	if err := s.buildingRepo.CreateFloor(floor); err != nil {
+		return nil, err
+	}
+
+	return floor, nil
+}
+
+func (s *RoomService) DeleteFloor(id uuid.UUID) error {
+	if _, err := s.buildingRepo.FindFloorByID(id); err != nil {
+		return errors.New("floor not found")
+	}
+
+	count, err := s.buildingRepo.CountRooms(id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("floor still has rooms")
+	}
+
+	return s.buildingRepo.DeleteFloor(id)
+}
+
+func (s *RoomService) CreateRoom(req dto.CreateRoomRequest) (*RoomOccupancy, error) {
+	floor, err := s.buildingRepo.FindFloorByID(req.FloorID)
+	if err != nil {
+		return nil, errors.New("floor not found")
+	}
+
+	room := &model.Room{
+		FloorID:  req.FloorID,
+		Number:   req.Number,
+		Capacity: req.Capacity,
+		Gender:   model.Gender(req.Gender),
+		Beds:     newBeds(uuid.Nil, 0, req.Capacity),
+	}
+
+	if err := s.roomRepo.Create(room, floor.BuildingID); err != nil {
+		return nil, err
+	}
+
+	return s.GetRoom(room.ID)
+}
+
+func (s *RoomService) GetRoom(id uuid.UUID) (*RoomOccupancy, error) {
+	room, err := s.roomRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("room not found")
+	}
+	return s.withOccupants(room)
+}
+
+func (s *RoomService) GetRooms(query dto.RoomListQuery) ([]RoomOccupancy, *dto.Pagination, error) {
+	rooms, meta, err := s.roomRepo.FindPage(query.RoomQuery, query.PageQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bedIDs []uuid.UUID
+	for _, room := range rooms {
+		for _, bed := range room.Beds {
+			bedIDs = append(bedIDs, bed.ID)
+		}
+	}
+
+	students, err := s.roomRepo.FindOccupants(bedIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	byBed := make(map[uuid.UUID]model.Student, len(students))
+	for _, student := range students {
+		byBed[*student.BedID] = student
+	}
+
+	result := []RoomOccupancy{}
+	for _, room := range rooms {
+		occupancy := RoomOccupancy{Room: room, Occupants: map[uuid.UUID]model.Student{}}
+		for _, bed := range room.Beds {
+			if student, ok := byBed[bed.ID]; ok {
+				occupancy.Occupants[bed.ID] = student
+			}
+		}
+		result = append(result, occupancy)
+	}
+
+	return result, meta, nil
+}
+
+func (s *RoomService) UpdateRoom(id uuid.UUID, req dto.UpdateRoomRequest) (*RoomOccupancy, error) {
+	occupancy, err := s.GetRoom(id)
+	if err != nil {
+		return nil, err
+	}
+	room := &occupancy.Room
+
+	if req.Number != "" {
+		room.Number = req.Number
+	}
+
+	if req.Gender != "" && model.Gender(req.Gender) != room.Gender {
+		for _, student := range occupancy.Occupants {
+			if student.Gender != "" && student.Gender != model.Gender(req.Gender) {
+				return nil, errors.New("room has occupants of a different gender")
+			}
+		}
+		room.Gender = model.Gender(req.Gender)
+	}
+
+	var addBeds []model.Bed
+	var removeBedIDs []uuid.UUID
+	if req.Capacity > 0 && req.Capacity != len(room.Beds) {
+		if req.Capacity > len(room.Beds) {
+			addBeds = newBeds(room.ID, nextBedNumber(room.Beds), req.Capacity-len(room.Beds))
+		} else {
+			for i := len(room.Beds) - 1; i >= 0 && len(room.Beds)-len(removeBedIDs) > req.Capacity; i-- {
+				if _, occupied := occupancy.Occupants[room.Beds[i].ID]; !occupied {
+					removeBedIDs = append(removeBedIDs, room.Beds[i].ID)
+				}
+			}
+			if len(room.Beds)-len(removeBedIDs) > req.Capacity {
+				return nil, errors.New("capacity is below the current number of occupants")
+			}
+		}
+		room.Capacity = req.Capacity
+	}
+
+	if err := s.roomRepo.Update(room, room.Floor.BuildingID, addBeds, removeBedIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetRoom(id)
+}
+
+func (s *RoomService) DeleteRoom(id uuid.UUID) error {
+	occupancy, err := s.GetRoom(id)
+	if err != nil {
+		return err
+	}
+	if len(occupancy.Occupants) > 0 {
+		return errors.New("room still has occupants")
+	}
+	return s.roomRepo.Delete(id)
+}
+
+func (s *RoomService) GetOccupancy() ([]dto.FloorOccupancyResponse, error) {
+	buildings, err := s.buildingRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.roomRepo.FindAll(dto.RoomQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.roomRepo.CountOccupantsByRoom()
+	if err != nil {
+		return nil, err
+	}
+
+	byFloor := map[uuid.UUID]*dto.FloorOccupancyResponse{}
+	result := []dto.FloorOccupancyResponse{}
+	for _, building := range buildings {
+		for _, floor := range building.Floors {
+			result = append(result, dto.FloorOccupancyResponse{
+				BuildingID:   building.ID,
+				BuildingName: building.Name,
+				FloorID:      floor.ID,
+				Floor:        floor.Number,
+			})
+		}
+	}
+	for i := range result {
+		byFloor[result[i].FloorID] = &result[i]
+	}
+
+	for _, room := range rooms {
+		floor, ok := byFloor[room.FloorID]
+		if !ok {
+			continue
+		}
+		floor.Rooms++
+		floor.Capacity += len(room.Beds)
+		floor.Occupied += counts[room.ID]
+	}
+	for i := range result {
+		result[i].Vacancies = result[i].Capacity - result[i].Occupied
+	}
+
+	return result, nil
+}
+
+func (s *RoomService) GetResidents(query dto.RoomResidentQuery) ([]model.RoomAssignment, error) {
+	from, err := time.ParseInLocation("2006-01-02", query.Date, time.Local)
+	if err != nil {
+		return nil, errors.New("invalid date")
+	}
+	return s.assignmentRepo.FindByRoom(query.Room, query.BuildingID, from, from.AddDate(0, 0, 1))
+}
+
+func (s *RoomService) FindBuildingByName(name string) (*model.Building, error) {
+	building, err := s.buildingRepo.FindByName(name)
+	if err != nil {
+		return nil, errors.New("building not found")
+	}
+	return building, nil
+}
+
+func (s *RoomService) FindRoom(buildingID uuid.UUID, number string) (*model.Room, error) {
+	rooms, err := s.roomRepo.FindByNumber(number, buildingID)
+	if err != nil {
+		return nil, err
+	}
+	switch len(rooms) {
+	case 0:
+		return nil, errors.New("room not found")
+	case 1:
+		return &rooms[0], nil
+	default:
+		return nil, errors.New("room number exists in several buildings; specify a building")
+	}
+}
+
+func (s *RoomService) FindAvailableBed(buildingID uuid.UUID, roomNumber string, bedID *uuid.UUID, student *model.Student, reserved, released map[uuid.UUID]bool) (*model.Bed, *model.Room, error) {
+	var room *model.Room
+	if bedID != nil {
+		bed, err := s.roomRepo.FindBedByID(*bedID)
+		if err != nil {
+			return nil, nil, errors.New("bed not found")
+		}
+		room, err = s.roomRepo.FindByID(bed.RoomID)
+		if err != nil {
+			return nil, nil, errors.New("room not found")
+		}
+	} else {
+		var err error
+		room, err = s.FindRoom(buildingID, roomNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if room.Gender != "" && student.Gender != "" && room.Gender != student.Gender {
+		return nil, nil, errors.New("room is designated for a different gender")
+	}
+
+	occupancy, err := s.withOccupants(room)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if bedID == nil && student.BedID != nil {
+		for i := range room.Beds {
+			if room.Beds[i].ID == *student.BedID && !reserved[room.Beds[i].ID] {
+				return &room.Beds[i], room, nil
+			}
+		}
+	}
+
+	for i := range room.Beds {
+		bed := &room.Beds[i]
+		if bedID != nil && bed.ID != *bedID {
+			continue
+		}
+		if reserved[bed.ID] {
+			continue
+		}
+		occupant, occupied := occupancy.Occupants[bed.ID]
+		if !occupied || occupant.ID == student.ID || released[occupant.ID] {
+			return bed, room, nil
+		}
+	}
+
+	if bedID != nil {
+		return nil, nil, errors.New("bed is already occupied")
+	}
+	return nil, nil, errors.New("room is full")
+}
+
+func (s *RoomService) withOccupants(room *model.Room) (*RoomOccupancy, error) {
+	bedIDs := make([]uuid.UUID, 0, len(room.Beds))
+	for _, bed := range room.Beds {
+		bedIDs = append(bedIDs, bed.ID)
+	}
+
+	students, err := s.roomRepo.FindOccupants(bedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	occupancy := &RoomOccupancy{Room: *room, Occupants: make(map[uuid.UUID]model.Student, len(students))}
+	for _, student := range students {
+		occupancy.Occupants[*student.BedID] = student
+	}
+	return occupancy, nil
+}
+
+func newBeds(roomID uuid.UUID, start, count int) []model.Bed {
+	beds := make([]model.Bed, 0, count)
+	for i := 1; i <= count; i++ {
+		beds = append(beds, model.Bed{RoomID: roomID, Label: strconv.Itoa(start + i)})
+	}
+	return beds
+}
+
+func nextBedNumber(beds []model.Bed) int {
+	highest := 0
+	for _, bed := range beds {
+		if n, err := strconv.Atoi(bed.Label); err == nil && n > highest {
+			highest = n
+		}
+	}
+	return highest
+}
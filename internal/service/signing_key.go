@@ -0,0 +1,281 @@
+package service
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"dormi-api/internal/config"
+	"dormi-api/internal/dto"
+	"dormi-api/internal/model"
+	"dormi-api/internal/repository"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	signingKeyCacheTTL   = 30 * time.Second
+	signingKeyMissReload = 5 * time.Second
+	signingKeyClockSkew  = time.Minute
+	signingKeyRSABits    = 2048
+	signingKeyIDByteSize = 8
+)
+
+var signingMethods = map[string]jwt.SigningMethod{
+	model.SigningAlgorithmEdDSA: jwt.SigningMethodEdDSA,
+	model.SigningAlgorithmRS256: jwt.SigningMethodRS256,
+}
+
+type signingKey struct {
+	record     model.SigningKey
+	method     jwt.SigningMethod
+	privateKey crypto.Signer
+	publicKey  crypto.PublicKey
+}
+
+type SigningKeyService struct {
+	signingKeyRepo *repository.SigningKeyRepository
+	cfg            *config.Config
+
+	mu       sync.RWMutex
+	keys     map[string]*signingKey
+	current  *signingKey
+	loadedAt time.Time
+}
+
+func NewSigningKeyService(signingKeyRepo *repository.SigningKeyRepository, cfg *config.Config) *SigningKeyService {
+	return &SigningKeyService{signingKeyRepo: signingKeyRepo, cfg: cfg}
+}
+
+func (s *SigningKeyService) EnsureActiveKey() error {
+	if err := s.reload(); err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	current := s.current
+	s.mu.RUnlock()
+
+	if current != nil {
+		return nil
+	}
+
+	_, err := s.Rotate()
+	return err
+}
+
+func (s *SigningKeyService) Rotate() (*model.SigningKey, error) {
+	record, err := generateSigningKey(s.cfg.JWTSigningAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	retiresAt := time.Now().Add(max(s.cfg.AccessTokenTTL, s.cfg.ImpersonationTTL, mfaTokenTTL) + signingKeyClockSkew)
+	if err := s.signingKeyRepo.Rotate(record, retiresAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (s *SigningKeyService) GetAll() ([]model.SigningKey, error) {
+	return s.signingKeyRepo.FindUsable(time.Now())
+}
+
+func (s *SigningKeyService) Sign(claims jwt.Claims) (string, error) {
+	s.reloadIfStale()
+
+	s.mu.RLock()
+	current := s.current
+	s.mu.RUnlock()
+
+	if current == nil {
+		return "", errors.New("no active signing key")
+	}
+
+	token := jwt.NewWithClaims(current.method, claims)
+	token.Header["kid"] = current.record.ID
+	return token.SignedString(current.privateKey)
+}
+
+func (s *SigningKeyService) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return nil, errors.New("missing key id")
+	}
+
+	key := s.find(kid)
+	if key == nil && s.loadedBefore(time.Now().Add(-signingKeyMissReload)) {
+		s.reload()
+		key = s.find(kid)
+	}
+	if key == nil {
+		return nil, errors.New("unknown key id")
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+
+	return key.publicKey, nil
+}
+
+func (s *SigningKeyService) JWKS() dto.JWKSResponse {
+	s.reloadIfStale()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resp := dto.JWKSResponse{Keys: []dto.JWK{}}
+	for _, key := range s.keys {
+		jwk := dto.JWK{
+			Kid: key.record.ID,
+			Use: "sig",
+			Alg: key.record.Algorithm,
+		}
+
+		switch pub := key.publicKey.(type) {
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		default:
+			continue
+		}
+
+		resp.Keys = append(resp.Keys, jwk)
+	}
+
+	return resp
+}
+
+func (s *SigningKeyService) find(kid string) *signingKey {
+	s.reloadIfStale()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.keys[kid]
+}
+
+func (s *SigningKeyService) loadedBefore(t time.Time) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.loadedAt.Before(t)
+}
+
+func (s *SigningKeyService) reloadIfStale() {
+	if s.loadedBefore(time.Now().Add(-signingKeyCacheTTL)) {
+		s.reload()
+	}
+}
+
+func (s *SigningKeyService) reload() error {
+	records, err := s.signingKeyRepo.FindUsable(time.Now())
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*signingKey, len(records))
+	var current *signingKey
+	for _, record := range records {
+		key, err := parseSigningKey(record)
+		if err != nil {
+			return err
+		}
+		keys[record.ID] = key
+		if record.Active && current == nil {
+			current = key
+		}
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.current = current
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+
+	return nil
+}
+
+func generateSigningKey(algorithm string) (*model.SigningKey, error) {
+	var privateKey crypto.Signer
+	var err error
+
+	switch algorithm {
+	case model.SigningAlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	case model.SigningAlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, signingKeyRSABits)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(publicDER)
+
+	return &model.SigningKey{
+		ID:         hex.EncodeToString(sum[:signingKeyIDByteSize]),
+		Algorithm:  algorithm,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+	}, nil
+}
+
+func parseSigningKey(record model.SigningKey) (*signingKey, error) {
+	method, ok := signingMethods[record.Algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", record.Algorithm)
+	}
+
+	block, _ := pem.Decode([]byte(record.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("invalid private key for %s", record.ID)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key for %s", record.ID)
+	}
+
+	return &signingKey{
+		record:     record,
+		method:     method,
+		privateKey: privateKey,
+		publicKey:  privateKey.Public(),
+	}, nil
+}
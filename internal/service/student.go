@@ -1,11 +1,16 @@
 package service
 
 import (
-	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"dormi-api/internal/config"
 	"dormi-api/internal/dto"
 	"dormi-api/internal/model"
 	"dormi-api/internal/repository"
@@ -13,12 +18,53 @@ import (
 	"github.com/google/uuid"
 )
 
+var ErrStudentNotFound = errors.New("student not found")
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 -]{6,18}[0-9]$`)
+
+const importHeaderSearchRows = 10
+
+var importHeaders = map[string]string{
+	"studentnumber": "studentNumber",
+	"학번":            "studentNumber",
+	"name":          "name",
+	"이름":            "name",
+	"성명":            "name",
+	"building":      "building",
+	"건물":            "building",
+	"동":             "building",
+	"roomnumber":    "roomNumber",
+	"room":          "roomNumber",
+	"호실":            "roomNumber",
+	"grade":         "grade",
+	"학년":            "grade",
+	"gender":        "gender",
+	"성별":            "gender",
+	"homeroomclass": "homeroomClass",
+	"class":         "homeroomClass",
+	"반":             "homeroomClass",
+}
+
+var importGenders = map[string]model.Gender{
+	"male":   model.GenderMale,
+	"m":      model.GenderMale,
+	"남":      model.GenderMale,
+	"남자":     model.GenderMale,
+	"female": model.GenderFemale,
+	"f":      model.GenderFemale,
+	"여":      model.GenderFemale,
+	"여자":     model.GenderFemale,
+}
+
 type StudentService struct {
-	studentRepo *repository.StudentRepository
+	studentRepo    *repository.StudentRepository
+	assignmentRepo *repository.RoomAssignmentRepository
+	roomService    *RoomService
+	cfg            *config.Config
 }
 
-func NewStudentService(studentRepo *repository.StudentRepository) *StudentService {
-	return &StudentService{studentRepo: studentRepo}
+func NewStudentService(studentRepo *repository.StudentRepository, assignmentRepo *repository.RoomAssignmentRepository, roomService *RoomService, cfg *config.Config) *StudentService {
+	return &StudentService{studentRepo: studentRepo, assignmentRepo: assignmentRepo, roomService: roomService, cfg: cfg}
 }
 
 func (s *StudentService) Create(req dto.CreateStudentRequest) (*model.Student, error) {
@@ -30,11 +76,35 @@ func (s *StudentService) Create(req dto.CreateStudentRequest) (*model.Student, e
 		return nil, errors.New("student number already exists")
 	}
 
+	birthDate, err := parseBirthDate(req.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+	if req.Phone != "" && !phonePattern.MatchString(req.Phone) {
+		return nil, errors.New("invalid phone number")
+	}
+	contacts, err := toEmergencyContacts(req.EmergencyContacts)
+	if err != nil {
+		return nil, err
+	}
+
 	student := &model.Student{
-		StudentNumber: req.StudentNumber,
-		Name:          req.Name,
-		RoomNumber:    req.RoomNumber,
-		Grade:         req.Grade,
+		StudentNumber:     req.StudentNumber,
+		Name:              req.Name,
+		Grade:             req.Grade,
+		Gender:            model.Gender(req.Gender),
+		HomeroomClass:     req.HomeroomClass,
+		Status:            model.StudentStatusActive,
+		BirthDate:         birthDate,
+		Phone:             req.Phone,
+		MedicalNotes:      req.MedicalNotes,
+		EmergencyContacts: contacts,
+	}
+
+	if req.RoomNumber != "" || req.BedID != nil {
+		if err := s.assignBed(student, req.BuildingID, req.RoomNumber, req.BedID, nil, nil); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := s.studentRepo.Create(student); err != nil {
@@ -48,8 +118,8 @@ func (s *StudentService) GetByID(id uuid.UUID) (*model.Student, error) {
 	return s.studentRepo.FindByID(id)
 }
 
-func (s *StudentService) GetAll(query dto.StudentQuery) ([]model.Student, error) {
-	return s.studentRepo.FindAll(query)
+func (s *StudentService) GetAll(query dto.StudentQuery, page dto.PageQuery) ([]model.Student, *dto.Pagination, error) {
+	return s.studentRepo.FindPage(query, page)
 }
 
 func (s *StudentService) Update(id uuid.UUID, req dto.UpdateStudentRequest) (*model.Student, error) {
@@ -71,12 +141,47 @@ func (s *StudentService) Update(id uuid.UUID, req dto.UpdateStudentRequest) (*mo
 	if req.Name != "" {
 		student.Name = req.Name
 	}
-	if req.RoomNumber != "" {
-		student.RoomNumber = req.RoomNumber
-	}
 	if req.Grade > 0 {
 		student.Grade = req.Grade
 	}
+	if req.Gender != "" {
+		student.Gender = model.Gender(req.Gender)
+	}
+	if req.BedID != nil || (req.RoomNumber != "" && (req.RoomNumber != student.RoomNumber || student.BedID == nil || req.BuildingID != uuid.Nil)) {
+		if err := s.assignBed(student, req.BuildingID, req.RoomNumber, req.BedID, nil, nil); err != nil {
+			return nil, err
+		}
+	} else if req.Gender != "" && student.BedID != nil {
+		if err := s.assignBed(student, uuid.Nil, "", student.BedID, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+	if req.HomeroomClass != "" {
+		student.HomeroomClass = req.HomeroomClass
+	}
+	if req.BirthDate != nil {
+		birthDate, err := parseBirthDate(*req.BirthDate)
+		if err != nil {
+			return nil, err
+		}
+		student.BirthDate = birthDate
+	}
+	if req.Phone != nil {
+		if *req.Phone != "" && !phonePattern.MatchString(*req.Phone) {
+			return nil, errors.New("invalid phone number")
+		}
+		student.Phone = *req.Phone
+	}
+	if req.MedicalNotes != nil {
+		student.MedicalNotes = *req.MedicalNotes
+	}
+	if req.EmergencyContacts != nil {
+		contacts, err := toEmergencyContacts(*req.EmergencyContacts)
+		if err != nil {
+			return nil, err
+		}
+		student.EmergencyContacts = contacts
+	}
 
 	if err := s.studentRepo.Update(student); err != nil {
 		return nil, err
@@ -85,52 +190,415 @@ func (s *StudentService) Update(id uuid.UUID, req dto.UpdateStudentRequest) (*mo
 	return student, nil
 }
 
+func (s *StudentService) Move(id uuid.UUID, req dto.MoveStudentRequest) (*model.Student, error) {
+	if req.RoomNumber == "" && req.BedID == nil {
+		return nil, errors.New("room number or bed id is required")
+	}
+
+	student, err := s.studentRepo.FindByID(id)
+	if err != nil {
+		return nil, ErrStudentNotFound
+	}
+
+	reserved := map[uuid.UUID]bool{}
+	if student.BedID != nil {
+		if req.BedID != nil && *req.BedID == *student.BedID {
+			return nil, errors.New("student is already assigned to this bed")
+		}
+		reserved[*student.BedID] = true
+	}
+
+	if err := s.assignBed(student, req.BuildingID, req.RoomNumber, req.BedID, reserved, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.studentRepo.Move(student, req.Reason, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return student, nil
+}
+
+func (s *StudentService) GetAssignments(id uuid.UUID, page dto.PageQuery) ([]model.RoomAssignment, *dto.Pagination, error) {
+	if _, err := s.studentRepo.FindByID(id); err != nil {
+		return nil, nil, ErrStudentNotFound
+	}
+	return s.assignmentRepo.FindByStudentID(id, page)
+}
+
 func (s *StudentService) Delete(id uuid.UUID) error {
 	return s.studentRepo.Delete(id)
 }
 
-func (s *StudentService) ImportCSV(reader io.Reader) (int, error) {
-	csvReader := csv.NewReader(reader)
+func (s *StudentService) GetDeleted(query dto.StudentQuery, page dto.PageQuery) ([]model.Student, *dto.Pagination, error) {
+	return s.studentRepo.FindDeleted(query, page)
+}
 
-	_, err := csvReader.Read()
+func (s *StudentService) Restore(id uuid.UUID) (*model.Student, error) {
+	student, err := s.studentRepo.FindDeletedByID(id)
 	if err != nil {
-		return 0, err
+		return nil, errors.New("deleted student not found")
 	}
 
-	var students []model.Student
-	for {
-		record, err := csvReader.Read()
-		if err == io.EOF {
-			break
+	exists, err := s.studentRepo.ExistsByStudentNumber(student.StudentNumber)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("student number is already in use by another student")
+	}
+
+	if student.BedID != nil {
+		if _, _, err := s.roomService.FindAvailableBed(uuid.Nil, "", student.BedID, student, nil, nil); err != nil {
+			student.BedID = nil
+			student.RoomNumber = ""
 		}
-		if err != nil {
-			return 0, err
+	}
+
+	if err := s.studentRepo.Restore(student, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return student, nil
+}
+
+func (s *StudentService) Purge(id uuid.UUID) error {
+	if _, err := s.studentRepo.FindDeletedByID(id); err != nil {
+		return errors.New("deleted student not found")
+	}
+	return s.studentRepo.Purge(id, s.cfg.StudentPurgePoints == model.PurgePointsDelete)
+}
+
+func (s *StudentService) Import(file io.Reader, format string, req dto.ImportStudentsRequest) (*dto.StudentImportResponse, error) {
+	var records []importRecord
+	var err error
+	if format == "xlsx" {
+		records, err = readXLSX(file, req.Sheet)
+	} else {
+		records, err = readCSV(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns, records, err := importColumns(records)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := req.DryRun
+	report := &dto.StudentImportResponse{DryRun: dryRun, Errors: []dto.StudentImportError{}}
+	var rows []*importedStudent
+	seen := map[string]int{}
+	for _, record := range records {
+		values := map[string]string{}
+		blank := true
+		for i, value := range record.values {
+			if field, ok := columns[i]; ok {
+				values[field] = strings.TrimSpace(value)
+				blank = blank && values[field] == ""
+			}
+		}
+		if blank {
+			report.Skipped++
+			continue
 		}
 
-		if len(record) < 4 {
+		row, rowErrors := s.importRow(record.line, values, seen)
+		if len(rowErrors) > 0 {
+			report.Errors = append(report.Errors, rowErrors...)
 			continue
 		}
+		rows = append(rows, row)
+	}
 
-		grade, err := strconv.Atoi(record[3])
+	report.Errors = append(report.Errors, s.placeImported(rows)...)
+
+	var created, updated []model.Student
+	for _, row := range rows {
+		switch {
+		case row.failed:
+		case row.isNew:
+			created = append(created, *row.student)
+			report.Created++
+		case row.changed:
+			updated = append(updated, *row.student)
+			report.Updated++
+		default:
+			report.Skipped++
+		}
+	}
+
+	if len(report.Errors) > 0 {
+		if dryRun {
+			return report, nil
+		}
+		return report, errors.New("import contains invalid rows")
+	}
+	if report.Created+report.Updated+report.Skipped == 0 {
+		return nil, errors.New("no records found")
+	}
+
+	if !dryRun {
+		if err := s.studentRepo.Import(created, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+func (s *StudentService) Export(query dto.StudentQuery, format string) ([]byte, int, error) {
+	students, err := s.studentRepo.FindAllWithBuilding(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var data []byte
+	if format == "xlsx" {
+		data, err = writeXLSX(students)
+	} else {
+		data, err = writeCSV(students)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, len(students), nil
+}
+
+type importedStudent struct {
+	line       int
+	student    *model.Student
+	isNew      bool
+	changed    bool
+	failed     bool
+	buildingID uuid.UUID
+	roomNumber string
+	checkBed   bool
+}
+
+func (r *importedStudent) keepsRoom() bool {
+	return r.student.BedID != nil && (r.roomNumber == "" || r.roomNumber == r.student.RoomNumber)
+}
+
+func (s *StudentService) importRow(line int, values map[string]string, seen map[string]int) (*importedStudent, []dto.StudentImportError) {
+	var errs []dto.StudentImportError
+	fail := func(field, message string) {
+		errs = append(errs, dto.StudentImportError{Row: line, Field: field, Message: message})
+	}
+
+	number := values["studentNumber"]
+	switch {
+	case number == "":
+		fail("studentNumber", "student number is required")
+	case utf8.RuneCountInString(number) > 20:
+		fail("studentNumber", "student number must be at most 20 characters")
+	case seen[number] > 0:
+		fail("studentNumber", fmt.Sprintf("duplicate student number (also on row %d)", seen[number]))
+	default:
+		seen[number] = line
+	}
+
+	name := values["name"]
+	if name == "" {
+		fail("name", "name is required")
+	} else if utf8.RuneCountInString(name) > 100 {
+		fail("name", "name must be at most 100 characters")
+	}
+
+	grade, err := strconv.Atoi(values["grade"])
+	if err != nil || grade < 1 || grade > 3 {
+		fail("grade", "grade must be 1, 2 or 3")
+	}
+
+	var gender model.Gender
+	if value := values["gender"]; value != "" {
+		var ok bool
+		if gender, ok = importGenders[strings.ToLower(value)]; !ok {
+			fail("gender", "gender must be MALE or FEMALE")
+		}
+	}
+
+	homeroomClass := values["homeroomClass"]
+	if utf8.RuneCountInString(homeroomClass) > 20 {
+		fail("homeroomClass", "homeroom class must be at most 20 characters")
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	student, err := s.studentRepo.FindByStudentNumber(number)
+	isNew := err != nil
+	if isNew {
+		student = &model.Student{StudentNumber: number, Status: model.StudentStatusActive}
+	}
+
+	changed := isNew
+	if student.Name != name {
+		student.Name = name
+		changed = true
+	}
+	if student.Grade != grade {
+		student.Grade = grade
+		changed = true
+	}
+	genderChanged := gender != "" && student.Gender != gender
+	if genderChanged {
+		student.Gender = gender
+		changed = true
+	}
+	if homeroomClass != "" && student.HomeroomClass != homeroomClass {
+		student.HomeroomClass = homeroomClass
+		changed = true
+	}
+
+	var buildingID uuid.UUID
+	if name := values["building"]; name != "" {
+		building, err := s.roomService.FindBuildingByName(name)
 		if err != nil {
-			continue
+			fail("building", err.Error())
+			return nil, errs
 		}
+		buildingID = building.ID
+	}
 
-		students = append(students, model.Student{
-			StudentNumber: record[0],
-			Name:          record[1],
-			RoomNumber:    record[2],
-			Grade:         grade,
-		})
+	row := &importedStudent{line: line, student: student, isNew: isNew, changed: changed}
+	roomNumber := values["roomNumber"]
+	if roomNumber != "" && (roomNumber != student.RoomNumber || student.BedID == nil || buildingID != uuid.Nil) {
+		row.buildingID = buildingID
+		row.roomNumber = roomNumber
+	} else if genderChanged && student.BedID != nil {
+		row.checkBed = true
 	}
 
-	if len(students) == 0 {
-		return 0, errors.New("no valid records found")
+	return row, nil
+}
+
+func (s *StudentService) placeImported(rows []*importedStudent) []dto.StudentImportError {
+	released := map[uuid.UUID]bool{}
+	for _, row := range rows {
+		if row.roomNumber != "" && row.student.BedID != nil {
+			released[row.student.ID] = true
+		}
 	}
 
-	if err := s.studentRepo.CreateBatch(students); err != nil {
-		return 0, err
+	var errs []dto.StudentImportError
+	reserved := map[uuid.UUID]bool{}
+	place := func(row *importedStudent) {
+		student := row.student
+		switch {
+		case row.roomNumber != "":
+			previous := student.BedID
+			if err := s.assignBed(student, row.buildingID, row.roomNumber, nil, reserved, released); err != nil {
+				errs = append(errs, dto.StudentImportError{Row: row.line, Field: "roomNumber", Message: err.Error()})
+				row.failed = true
+				return
+			}
+			if !model.SameBed(previous, student.BedID) {
+				row.changed = true
+			}
+		case row.checkBed:
+			if err := s.assignBed(student, uuid.Nil, "", student.BedID, reserved, released); err != nil {
+				errs = append(errs, dto.StudentImportError{Row: row.line, Field: "gender", Message: err.Error()})
+				row.failed = true
+				return
+			}
+		}
+		if student.BedID != nil {
+			reserved[*student.BedID] = true
+		}
 	}
 
-	return len(students), nil
+	for _, row := range rows {
+		if row.keepsRoom() {
+			place(row)
+		}
+	}
+	for _, row := range rows {
+		if !row.keepsRoom() {
+			place(row)
+		}
+	}
+
+	return errs
+}
+
+func importColumns(records []importRecord) (map[int]string, []importRecord, error) {
+	var firstErr error
+	for i, record := range records {
+		if i >= importHeaderSearchRows {
+			break
+		}
+
+		columns := map[int]string{}
+		found := map[string]bool{}
+		for j, name := range record.values {
+			key := strings.ToLower(strings.TrimSpace(name))
+			key = strings.NewReplacer(" ", "", "_", "", "-", "").Replace(key)
+			if field, ok := importHeaders[key]; ok && !found[field] {
+				columns[j] = field
+				found[field] = true
+			}
+		}
+
+		var err error
+		for _, field := range []string{"studentNumber", "name", "grade"} {
+			if !found[field] {
+				err = fmt.Errorf("missing required column: %s", field)
+				break
+			}
+		}
+		if err == nil {
+			return columns, records[i+1:], nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr == nil {
+		firstErr = errors.New("file is empty")
+	}
+	return nil, nil, firstErr
+}
+
+func parseBirthDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	birthDate, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, errors.New("invalid birth date")
+	}
+	if birthDate.After(time.Now()) {
+		return nil, errors.New("birth date cannot be in the future")
+	}
+	return &birthDate, nil
+}
+
+func toEmergencyContacts(reqs []dto.EmergencyContactRequest) ([]model.EmergencyContact, error) {
+	contacts := make([]model.EmergencyContact, 0, len(reqs))
+	for _, r := range reqs {
+		if !phonePattern.MatchString(r.Phone) {
+			return nil, errors.New("invalid emergency contact phone number")
+		}
+		contacts = append(contacts, model.EmergencyContact{
+			Name:         r.Name,
+			Relationship: r.Relationship,
+			Phone:        r.Phone,
+		})
+	}
+	return contacts, nil
+}
+
+func (s *StudentService) assignBed(student *model.Student, buildingID uuid.UUID, roomNumber string, bedID *uuid.UUID, reserved, released map[uuid.UUID]bool) error {
+	bed, room, err := s.roomService.FindAvailableBed(buildingID, roomNumber, bedID, student, reserved, released)
+	if err != nil {
+		return err
+	}
+	student.BedID = &bed.ID
+	student.RoomNumber = room.Number
+	return nil
 }
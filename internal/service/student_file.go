@@ -0,0 +1,206 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"dormi-api/internal/model"
+
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding/korean"
+)
+
+const exportSheetName = "학생"
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+const formulaPrefixes = "=+-@\t\r"
+
+var exportHeaders = []string{"학번", "이름", "학년", "반", "성별", "건물", "호실"}
+
+type importRecord struct {
+	line   int
+	values []string
+}
+
+func readCSV(file io.Reader) ([]importRecord, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	hasBOM := bytes.HasPrefix(data, utf8BOM)
+	data, err = decodeText(data)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := false
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records []importRecord
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("file is not a valid csv: " + err.Error())
+		}
+		if len(records) == 0 {
+			exported = hasBOM && slices.Equal(values, exportHeaders)
+		} else if exported {
+			for i, value := range values {
+				values[i] = unescapeFormula(value)
+			}
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, importRecord{line: line, values: values})
+	}
+	return records, nil
+}
+
+func decodeText(data []byte) ([]byte, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if utf8.Valid(data) {
+		return data, nil
+	}
+
+	decoded, err := korean.EUCKR.NewDecoder().Bytes(data)
+	if err != nil || bytes.ContainsRune(decoded, utf8.RuneError) {
+		return nil, errors.New("file encoding must be UTF-8 or EUC-KR (CP949)")
+	}
+	return decoded, nil
+}
+
+func readXLSX(file io.Reader, sheet string) ([]importRecord, error) {
+	f, err := excelize.OpenReader(file, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, errors.New("file is not a valid xlsx")
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, errors.New("file is empty")
+		}
+		sheet = sheets[0]
+	} else if index, err := f.GetSheetIndex(sheet); err != nil || index < 0 {
+		return nil, fmt.Errorf("sheet not found: %s", sheet)
+	}
+
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]importRecord, 0, len(rows))
+	for i, values := range rows {
+		records = append(records, importRecord{line: i + 1, values: values})
+	}
+	return records, nil
+}
+
+func exportRow(s *model.Student) []string {
+	var building string
+	if s.Bed != nil && s.Bed.Room != nil && s.Bed.Room.Floor != nil && s.Bed.Room.Floor.Building != nil {
+		building = s.Bed.Room.Floor.Building.Name
+	}
+	return []string{
+		s.StudentNumber,
+		s.Name,
+		strconv.Itoa(s.Grade),
+		s.HomeroomClass,
+		string(s.Gender),
+		building,
+		s.RoomNumber,
+	}
+}
+
+func writeCSV(students []model.Student) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(utf8BOM)
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(exportHeaders); err != nil {
+		return nil, err
+	}
+	for i := range students {
+		row := exportRow(&students[i])
+		for j, value := range row {
+			row[j] = escapeFormula(value)
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func unescapeFormula(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
+
+func writeXLSX(students []model.Student) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(f.GetSheetName(0), exportSheetName); err != nil {
+		return nil, err
+	}
+
+	header := make([]interface{}, len(exportHeaders))
+	for i, h := range exportHeaders {
+		header[i] = h
+	}
+	if err := f.SetSheetRow(exportSheetName, "A1", &header); err != nil {
+		return nil, err
+	}
+
+	for i := range students {
+		row := exportRow(&students[i])
+		values := make([]interface{}, len(row))
+		for j, v := range row {
+			values[j] = v
+		}
+		values[2] = students[i].Grade
+
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return nil, err
+		}
+		if err := f.SetSheetRow(exportSheetName, cell, &values); err != nil {
+			return nil, err
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
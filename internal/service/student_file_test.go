@@ -0,0 +1,115 @@
+package service
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"dormi-api/internal/model"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func encode(t *testing.T, enc encoding.Encoding, s string) []byte {
+	t.Helper()
+	b, err := enc.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestDecodeText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{name: "utf-8", data: []byte("학번,이름\n20260001,홍길동\n"), want: "학번,이름\n20260001,홍길동\n"},
+		{name: "utf-8 with bom", data: append([]byte{0xEF, 0xBB, 0xBF}, "학번,이름"...), want: "학번,이름"},
+		{name: "euc-kr", data: encode(t, korean.EUCKR, "학번,이름\n20260001,홍길동\n"), want: "학번,이름\n20260001,홍길동\n"},
+		{name: "cp949 extension", data: encode(t, korean.EUCKR, "똠방각하"), want: "똠방각하"},
+		{name: "latin-1", data: encode(t, charmap.ISO8859_1, "id,name\n20260001,Müller\n"), wantErr: true},
+		{name: "utf-16", data: encode(t, unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), "학번,이름"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeText(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSVFormulaEscaping(t *testing.T) {
+	tests := []struct {
+		value   string
+		escaped string
+	}{
+		{value: "홍길동", escaped: "홍길동"},
+		{value: "=HYPERLINK(\"http://evil\")", escaped: "'=HYPERLINK(\"http://evil\")"},
+		{value: "+821012345678", escaped: "'+821012345678"},
+		{value: "-1", escaped: "'-1"},
+		{value: "@SUM(A1)", escaped: "'@SUM(A1)"},
+		{value: "'quoted", escaped: "'quoted"},
+		{value: "", escaped: ""},
+	}
+
+	for _, tt := range tests {
+		if got := escapeFormula(tt.value); got != tt.escaped {
+			t.Errorf("escapeFormula(%q): got %q, want %q", tt.value, got, tt.escaped)
+		}
+		if got := unescapeFormula(tt.escaped); got != tt.value {
+			t.Errorf("unescapeFormula(%q): got %q, want %q", tt.escaped, got, tt.value)
+		}
+	}
+}
+
+func TestWriteCSVRoundTrip(t *testing.T) {
+	students := []model.Student{
+		{StudentNumber: "20260001", Name: "=1+1", Grade: 1, HomeroomClass: "@A", Gender: model.GenderMale},
+	}
+
+	data, err := writeCSV(students)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "'=1+1") || !strings.Contains(string(data), "'@A") {
+		t.Errorf("formula cells are not escaped: %q", data)
+	}
+
+	records, err := readCSV(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := records[1].values; got[1] != "=1+1" || got[3] != "@A" {
+		t.Errorf("got %q after reimport, want the original values", got)
+	}
+}
+
+func TestReadCSVKeepsQuotesWithoutExportMarker(t *testing.T) {
+	data := "학번,이름,학년,반,성별,건물,호실\n20260001,'=1+1,1,'@A,MALE,,\n"
+
+	records, err := readCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := records[1].values; got[1] != "'=1+1" || got[3] != "'@A" {
+		t.Errorf("got %q, want the quotes kept for a file not written by export", got)
+	}
+}